@@ -13,19 +13,22 @@
 // Arduino software without disclosing the source code of your own applications.
 // To purchase a commercial license, send an email to license@arduino.cc.
 
-// Package main generates Markdown documentation for the Arduino Lint CLI.
+// Package main generates Markdown documentation for the Arduino Lint CLI and rules.
 package main
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/arduino/arduino-lint/internal/cli"
+	"github.com/arduino/arduino-lint/internal/rule/ruledocumentation"
 	"github.com/spf13/cobra/doc"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		print("error: Please provide the output folder argument")
+	if len(os.Args) < 3 {
+		print("error: Please provide the command reference and rule reference output folder arguments")
 		os.Exit(1)
 	}
 
@@ -35,4 +38,15 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+
+	rulePages, err := ruledocumentation.ReferencePages()
+	if err != nil {
+		panic(err)
+	}
+	for fileName, content := range rulePages {
+		err := ioutil.WriteFile(filepath.Join(os.Args[2], fileName), []byte(content), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
 }
@@ -17,7 +17,10 @@
 package cli
 
 import (
+	"os"
+
 	"github.com/arduino/arduino-lint/internal/command"
+	"github.com/arduino/go-paths-helper"
 	"github.com/spf13/cobra"
 )
 
@@ -27,18 +30,118 @@ func Root() *cobra.Command {
 		Short:                 "Linter for Arduino projects.",
 		Long:                  "Arduino Lint checks for specification compliance and other common problems with Arduino projects",
 		DisableFlagsInUseLine: true,
-		Use:                   "arduino-lint [FLAG]... [PROJECT_PATH]...\n\nLint project in PROJECT_PATH or current path if no PROJECT_PATH argument provided.",
+		Use:                   "arduino-lint [FLAG]... [PROJECT_PATH]...\n\nLint project in PROJECT_PATH or current path if no PROJECT_PATH argument provided.\nPROJECT_PATH can also be a release archive (.zip, .tar.gz, or .tar.bz2).",
+		Args:                  cobra.ArbitraryArgs, // Otherwise, arguments that are not subcommands are rejected.
 		Run:                   command.ArduinoLint,
 	}
 
+	rootCommand.PersistentFlags().String("archives-dir", "", "Verify the checksum and size of the package index release archives found in this folder.")
+	rootCommand.PersistentFlags().String("baseline", "", "Don't fail on the rule violations recorded in this baseline file.")
+	rootCommand.PersistentFlags().String("cache-dir", "", "Folder to cache network data in. Default: arduino-lint in the user cache folder.")
+	rootCommand.PersistentFlags().StringSlice("category", nil, "Only run the rules of these categories or subcategories (e.g., structure,documentation).")
 	rootCommand.PersistentFlags().String("compliance", "specification", "Configure how strict the tool is. Can be {strict|specification|permissive}")
-	rootCommand.PersistentFlags().String("format", "text", "The output format can be {text|json}.")
+	rootCommand.PersistentFlags().String("config", "", "Load configuration from this file. Default: the .arduino-lint.yml file in the PROJECT_PATH, if present.")
+	rootCommand.PersistentFlags().Bool("dry-run", false, "Print the changes --fix would make as a unified diff, without writing them.")
+	rootCommand.PersistentFlags().String("fail-on", "error", "The lowest level of rule violation that causes a failure. Can be {error|warning|info|never}.")
+	rootCommand.PersistentFlags().Bool("fix", false, "Correct the mechanically fixable problems in the projects before linting.")
+	rootCommand.PersistentFlags().String("format", "text", "The output format can be {text|json|sarif|junit|github-actions}.")
+	rootCommand.PersistentFlags().Int("jobs", 0, "Number of projects to lint concurrently. Default: the number of CPUs.")
+	rootCommand.PersistentFlags().String("library-index", "", "Path or URL of the Library Manager index. Default: the official index at downloads.arduino.cc.")
 	rootCommand.PersistentFlags().String("library-manager", "", "Configure the rules for libraries in the Arduino Library Manager index. Can be {submit|update|false}.\nsubmit: Also run additional rules required to pass before a library is accepted for inclusion in the index.\nupdate: Also run additional rules required to pass before new releases of a library already in the index are accepted.\nfalse: Don't run any Library Manager-specific rules.")
+	rootCommand.PersistentFlags().Int("max-warnings", -1, "Fail if there are more than this number of warnings. Default: no limit.")
+	rootCommand.PersistentFlags().Bool("no-cache", false, "Don't cache network data.")
+	rootCommand.PersistentFlags().Bool("offline", false, "Don't access the network. Rules that require network access are skipped.")
+	rootCommand.PersistentFlags().String("package-index", "", "Verify platforms against their releases in this package index file.")
 	rootCommand.PersistentFlags().String("project-type", "all", "Only lint projects of the specified type and their subprojects. Can be {sketch|library|platform|all}.")
 	rootCommand.PersistentFlags().Bool("recursive", false, "Search path recursively for Arduino projects to lint. Can be {true|false}.")
 	rootCommand.PersistentFlags().String("report-file", "", "Save a report on the rules to this file.")
+	rootCommand.PersistentFlags().StringSlice("rules", nil, "Only run the rules with these IDs. IDs can be prefixes (e.g., LP) or globs (e.g., PF05?).")
+	rootCommand.PersistentFlags().StringSlice("skip-rules", nil, "Don't run the rules with these IDs. IDs can be prefixes (e.g., LP) or globs (e.g., PF05?).")
 	rootCommand.PersistentFlags().BoolP("verbose", "v", false, "Show more information while running rules.")
 	rootCommand.PersistentFlags().Bool("version", false, "Print version and timestamp of the build.")
+	rootCommand.PersistentFlags().Bool("watch", false, "Keep running after linting, and re-lint each project when its files change.")
+	rootCommand.PersistentFlags().String("write-baseline", "", "Record the current rule violations to this baseline file.")
+
+	rootCommand.AddCommand(rulesCommand())
+	rootCommand.AddCommand(lspCommand())
 
 	return rootCommand
 }
+
+// Arguments returns the command line arguments to execute the given root command with.
+// Before the subcommands were added, the arguments naming them could only be PROJECT_PATHs. In order to keep linting the
+// projects, when every subcommand argument is the path of an existing file or folder, they are passed as paths relative
+// to the working directory, which are not taken for the subcommands.
+func Arguments(rootCommand *cobra.Command, arguments []string) []string {
+	subcommand, _, err := rootCommand.Find(arguments)
+	if err != nil || subcommand == rootCommand {
+		return arguments
+	}
+
+	subcommandNames := []string{}
+	for ; subcommand != rootCommand; subcommand = subcommand.Parent() {
+		if !paths.New(subcommand.Name()).Exist() {
+			return arguments
+		}
+		subcommandNames = append([]string{subcommand.Name()}, subcommandNames...)
+	}
+
+	projectPathArguments := append([]string{}, arguments...)
+	for index := 0; index < len(projectPathArguments) && len(subcommandNames) > 0 && projectPathArguments[index] != "--"; index++ {
+		if projectPathArguments[index] == subcommandNames[0] {
+			projectPathArguments[index] = "." + string(os.PathSeparator) + subcommandNames[0]
+			subcommandNames = subcommandNames[1:]
+		}
+	}
+
+	return projectPathArguments
+}
+
+// rulesCommand creates the rules command, which provides documentation of the rules.
+func rulesCommand() *cobra.Command {
+	rulesCommand := &cobra.Command{
+		Short: "Documentation of the rules.",
+		Long:  "Documentation of the rules, generated from the rule configurations.",
+		Use:   "rules",
+	}
+
+	rulesListCommand := &cobra.Command{
+		Short:                 "List the rules.",
+		Long:                  "List the rules, optionally filtered by project type, category, and the modes they are enabled in.",
+		DisableFlagsInUseLine: true,
+		Use:                   "list [FLAG]...",
+		Args:                  cobra.NoArgs,
+		Run:                   command.RulesList,
+	}
+	rulesListCommand.Flags().String("category", "", "Only list rules of this category (e.g., structure).")
+	rulesListCommand.Flags().String("compliance", "", "Only list rules enabled in this compliance mode. Can be {strict|specification|permissive}")
+	rulesListCommand.Flags().String("format", "text", "The output format can be {text|json|markdown}.")
+	rulesListCommand.Flags().String("library-manager", "", "Only list rules enabled in this Library Manager mode. Can be {submit|update|false}.")
+	rulesListCommand.Flags().String("project-type", "all", "Only list rules for this project type. Can be {sketch|library|platform|package-index|all}.")
+	rulesCommand.AddCommand(rulesListCommand)
+
+	rulesExplainCommand := &cobra.Command{
+		Short:                 "Explain a rule.",
+		Long:                  "Show the full documentation of the rule with the given ID, including its level in every combination of rule modes.",
+		DisableFlagsInUseLine: true,
+		Use:                   "explain [FLAG]... RULE_ID",
+		Args:                  cobra.ExactArgs(1),
+		Run:                   command.RulesExplain,
+	}
+	rulesExplainCommand.Flags().String("format", "text", "The output format can be {text|json|markdown}.")
+	rulesCommand.AddCommand(rulesExplainCommand)
+
+	return rulesCommand
+}
+
+// lspCommand creates the lsp command, which runs the Language Server Protocol server.
+func lspCommand() *cobra.Command {
+	return &cobra.Command{
+		Short:                 "Run a Language Server Protocol server.",
+		Long:                  "Run a Language Server Protocol server on stdin and stdout, which provides diagnostics, quick fixes, and completion for the Arduino metadata files (library.properties, boards.txt, platform.txt, programmers.txt, sketch.json, and package index) as they are edited.\nThe flags configure the rules the same way as for linting.",
+		DisableFlagsInUseLine: true,
+		Use:                   "lsp [FLAG]...",
+		Args:                  cobra.NoArgs,
+		Run:                   command.LSP,
+	}
+}
@@ -0,0 +1,54 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArguments(t *testing.T) {
+	workingDirectoryPath, err := os.Getwd()
+	require.Nil(t, err)
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-cli-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+	require.Nil(t, os.Chdir(temporaryPath.String()))
+	defer os.Chdir(workingDirectoryPath)
+
+	rootCommand := Root()
+	relativePath := func(path string) string {
+		return "." + string(filepath.Separator) + path
+	}
+
+	assert.Equal(t, []string{"rules", "list"}, Arguments(rootCommand, []string{"rules", "list"}), "Subcommand")
+	assert.Equal(t, []string{"--verbose", "foo"}, Arguments(rootCommand, []string{"--verbose", "foo"}), "PROJECT_PATH")
+
+	require.Nil(t, temporaryPath.Join("rules").Mkdir())
+	assert.Equal(t, []string{"--verbose", relativePath("rules")}, Arguments(rootCommand, []string{"--verbose", "rules"}), "Existing PROJECT_PATH named after subcommand")
+	assert.Equal(t, []string{"rules", "list"}, Arguments(rootCommand, []string{"rules", "list"}), "Nested subcommand which is not an existing path")
+
+	require.Nil(t, temporaryPath.Join("list").Mkdir())
+	assert.Equal(t, []string{relativePath("rules"), relativePath("list")}, Arguments(rootCommand, []string{"rules", "list"}), "Existing PROJECT_PATHs named after nested subcommand")
+
+	require.Nil(t, temporaryPath.Join("lsp").WriteFile([]byte{}))
+	assert.Equal(t, []string{relativePath("lsp")}, Arguments(rootCommand, []string{"lsp"}), "Existing file named after subcommand")
+}
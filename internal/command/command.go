@@ -17,25 +17,36 @@
 package command
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/fix"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/result"
 	"github.com/arduino/arduino-lint/internal/result/feedback"
 	"github.com/arduino/arduino-lint/internal/result/outputformat"
 	"github.com/arduino/arduino-lint/internal/rule"
+	"github.com/arduino/arduino-lint/internal/watch"
 	"github.com/spf13/cobra"
 )
 
+// The exit statuses of arduino-lint.
+const (
+	ExitLintFailure        = 1 // The rules did not pass according to the --fail-on and --max-warnings settings.
+	ExitInternalError      = 2 // Also the exit status of the Go runtime on an unrecovered panic.
+	ExitConfigurationError = 3
+)
+
 // ArduinoLint is the root command function.
 func ArduinoLint(rootCommand *cobra.Command, cliArguments []string) {
 	if err := configuration.Initialize(rootCommand.Flags(), cliArguments); err != nil {
 		feedback.Errorf("Invalid configuration: %v", err)
-		os.Exit(1)
+		exit(ExitConfigurationError)
 	}
+	defer configuration.RemoveArchiveExtractions()
 
 	if configuration.VersionMode() {
 		if configuration.OutputFormat() == outputformat.Text {
@@ -65,15 +76,35 @@ func ArduinoLint(rootCommand *cobra.Command, cliArguments []string) {
 	}
 
 	result.Results.Initialize()
+	if err := result.Results.LoadBaseline(); err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		exit(ExitConfigurationError)
+	}
 
 	projects, err := project.FindProjects()
 	if err != nil {
 		feedback.Errorf("Error while finding projects: %v", err)
-		os.Exit(1)
+		exit(ExitConfigurationError)
+	}
+
+	if configuration.Fix() {
+		// Fixes are applied first, so the rule results reflect the fixed projects.
+		projects, err = fix.Projects(projects)
+		if err != nil {
+			feedback.Errorf("Error while fixing projects: %v", err)
+			exit(ExitInternalError)
+		}
 	}
 
-	for _, project := range projects {
-		rule.Runner(project)
+	// Projects are linted concurrently, but their results are recorded in the order the projects were found so that the
+	// output is deterministic.
+	ruleResultsChannels, err := rule.RunProjects(context.Background(), projects)
+	if err != nil {
+		feedback.Error(err.Error())
+		exit(ExitInternalError)
+	}
+	for index, project := range projects {
+		rule.Record(project, <-ruleResultsChannels[index])
 
 		// Rules are finished for this project, so summarize its rule results in the report.
 		result.Results.AddProjectSummary(project)
@@ -86,12 +117,23 @@ func ArduinoLint(rootCommand *cobra.Command, cliArguments []string) {
 	// All projects have been linted, so summarize their rule results in the report.
 	result.Results.AddSummary()
 
-	if configuration.OutputFormat() == outputformat.Text {
+	switch configuration.OutputFormat() {
+	case outputformat.Text:
 		if len(projects) > 1 {
 			// There are multiple projects, print the summary of rule results for all projects.
 			fmt.Println(result.Results.SummaryText())
 		}
-	} else {
+	case outputformat.SARIF:
+		// Print the complete SARIF formatted report.
+		fmt.Println(result.Results.SARIFReport())
+	case outputformat.JUnit:
+		// Print the complete JUnit XML formatted report.
+		fmt.Print(result.Results.JUnitReport())
+	case outputformat.GitHubActions:
+		// Print the workflow commands for the rule violation annotations, followed by a human readable summary for the log.
+		fmt.Print(result.Results.GitHubActionsReport())
+		fmt.Println(result.Results.SummaryText())
+	default:
 		// Print the complete JSON formatted report.
 		fmt.Println(result.Results.JSONReport())
 	}
@@ -100,11 +142,33 @@ func ArduinoLint(rootCommand *cobra.Command, cliArguments []string) {
 		// Write report file.
 		if err := result.Results.WriteReport(); err != nil {
 			feedback.Error(err.Error())
-			os.Exit(1)
+			exit(ExitInternalError)
+		}
+	}
+
+	if configuration.WriteBaselinePath() != nil {
+		// Write baseline file.
+		if err := result.Results.WriteBaseline(); err != nil {
+			feedback.Error(err.Error())
+			exit(ExitInternalError)
+		}
+	}
+
+	if configuration.Watch() {
+		// Fixes are only applied on the first pass, so the watched files are not changed under the user.
+		if err := watch.Projects(context.Background(), projects); err != nil {
+			feedback.Error(err.Error())
+			exit(ExitInternalError)
 		}
 	}
 
 	if !result.Results.Passed() {
-		os.Exit(1)
+		exit(ExitLintFailure)
 	}
 }
+
+// exit removes the temporary files and exits with the given status.
+func exit(status int) {
+	configuration.RemoveArchiveExtractions()
+	os.Exit(status)
+}
@@ -0,0 +1,47 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package command
+
+// The lsp command.
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/lsp"
+	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/spf13/cobra"
+)
+
+// LSP is the lsp command function.
+func LSP(lspCommand *cobra.Command, cliArguments []string) {
+	// The project is linted again on changes, so the rules which require network access would make the diagnostics slow.
+	if err := lspCommand.Flags().Set("offline", "true"); err != nil {
+		panic(err)
+	}
+	// The flags are validated before serving. The server initializes the configuration again for the project of each
+	// document it lints, so that the project's configuration file is used.
+	if err := configuration.Initialize(lspCommand.Flags(), nil); err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	// stdout is used for the protocol, so nothing else may be printed to it.
+	if err := lsp.NewServer(os.Stdin, os.Stdout, lspCommand.Flags()).Serve(); err != nil {
+		feedback.Error(err.Error())
+		os.Exit(ExitInternalError)
+	}
+}
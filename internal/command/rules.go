@@ -0,0 +1,132 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package command
+
+// The rules commands.
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/arduino/arduino-lint/internal/rule/ruledocumentation"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// RulesList is the rules list command function.
+func RulesList(rulesListCommand *cobra.Command, cliArguments []string) {
+	format, err := rulesFormat(rulesListCommand.Flags())
+	if err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	filter, err := rulesFilter(rulesListCommand.Flags())
+	if err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	ruleConfigurations, err := ruledocumentation.Select(filter)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err := ruledocumentation.List(ruleConfigurations, format)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(list)
+}
+
+// RulesExplain is the rules explain command function.
+func RulesExplain(rulesExplainCommand *cobra.Command, cliArguments []string) {
+	format, err := rulesFormat(rulesExplainCommand.Flags())
+	if err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	ruleConfiguration, err := ruledocumentation.Find(cliArguments[0])
+	if err != nil {
+		feedback.Error(err.Error())
+		os.Exit(ExitConfigurationError)
+	}
+
+	explanation, err := ruledocumentation.Explain(ruleConfiguration, format)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(explanation)
+}
+
+// rulesFormat returns the output format of the rules commands.
+func rulesFormat(flags *pflag.FlagSet) (string, error) {
+	format, _ := flags.GetString("format")
+	format = strings.ToLower(format)
+	switch format {
+	case ruledocumentation.Text, ruledocumentation.JSON, ruledocumentation.Markdown:
+		return format, nil
+	default:
+		return "", fmt.Errorf("--format flag value %s not valid", format)
+	}
+}
+
+// rulesFilter returns the criteria for selecting the rules to list.
+func rulesFilter(flags *pflag.FlagSet) (ruledocumentation.FilterType, error) {
+	var filter ruledocumentation.FilterType
+	var err error
+
+	projectTypeString, _ := flags.GetString("project-type")
+	filter.ProjectType, err = projecttype.FromString(projectTypeString)
+	if err != nil {
+		return filter, fmt.Errorf("--project-type flag value %s not valid", projectTypeString)
+	}
+
+	filter.Category, _ = flags.GetString("category")
+
+	// Rules are only filtered by whether they are enabled if a mode was specified.
+	complianceString, _ := flags.GetString("compliance")
+	libraryManagerString, _ := flags.GetString("library-manager")
+	if complianceString == "" && libraryManagerString == "" {
+		return filter, nil
+	}
+
+	// The modes which were not specified have the defaults for the project type, as when linting.
+	customRuleModes := make(map[rulemode.Type]bool)
+	if complianceString != "" {
+		customRuleModes[rulemode.Strict], customRuleModes[rulemode.Specification], customRuleModes[rulemode.Permissive], err = rulemode.ComplianceModeFromString(complianceString)
+		if err != nil {
+			return filter, fmt.Errorf("--compliance flag value %s not valid", complianceString)
+		}
+	}
+	if libraryManagerString != "" {
+		customRuleModes[rulemode.LibraryManagerSubmission], customRuleModes[rulemode.LibraryManagerIndexed], err = rulemode.LibraryManagerModeFromString(libraryManagerString)
+		if err != nil {
+			return filter, fmt.Errorf("--library-manager flag value %s not valid", libraryManagerString)
+		}
+	}
+	filter.RuleModes = func(projectType projecttype.Type) map[rulemode.Type]bool {
+		return configuration.RuleModesWith(customRuleModes, projectType)
+	}
+
+	return filter, nil
+}
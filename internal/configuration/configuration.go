@@ -20,10 +20,13 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 
+	"github.com/arduino/arduino-lint/internal/configuration/failon"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/archive"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/result/outputformat"
 	"github.com/arduino/go-paths-helper"
@@ -35,7 +38,94 @@ import (
 func Initialize(flags *pflag.FlagSet, projectPaths []string) error {
 	var err error
 
-	complianceString, _ := flags.GetString("compliance")
+	customRuleModes = make(map[rulemode.Type]bool) // Settings from any previous initialization must not persist.
+
+	archivesPathString, _ := flags.GetString("archives-dir")
+	archivesPath = paths.New(archivesPathString)
+	if archivesPath != nil {
+		archivesPathExists, err := archivesPath.ExistCheck()
+		if err != nil {
+			return fmt.Errorf("Unable to process --archives-dir flag value %s: %v", archivesPathString, err)
+		}
+		if !archivesPathExists {
+			return fmt.Errorf("--archives-dir flag value %s does not exist", archivesPathString)
+		}
+		if archivesPath.IsNotDir() {
+			return fmt.Errorf("--archives-dir flag value %s is not a folder", archivesPathString)
+		}
+	}
+
+	baselinePathString, _ := flags.GetString("baseline")
+	baselinePath = paths.New(baselinePathString)
+
+	cachePath = nil
+	if noCache, _ := flags.GetBool("no-cache"); !noCache {
+		cachePathString, _ := flags.GetString("cache-dir")
+		if cachePathString != "" {
+			cachePath = paths.New(cachePathString)
+		} else if userCachePath, err := os.UserCacheDir(); err == nil {
+			cachePath = paths.New(userCachePath, "arduino-lint")
+		} else {
+			logrus.Warnf("Unable to determine default cache folder, caching disabled: %s", err)
+		}
+	}
+
+	// The archive PROJECT_PATH arguments are extracted first, so that the configuration file is found in the extracted root.
+	fix, _ = flags.GetBool("fix")
+	watch, _ = flags.GetBool("watch")
+
+	targetPaths = nil
+	if len(projectPaths) == 0 {
+		// Default to using current working directory.
+		workingDirectoryPath, err := os.Getwd()
+		if err != nil {
+			panic(err)
+		}
+		targetPaths.Add(paths.New(workingDirectoryPath))
+	} else {
+		for _, projectPath := range projectPaths {
+			targetPath := paths.New(projectPath)
+			targetPathExists, err := targetPath.ExistCheck()
+			if err != nil {
+				return fmt.Errorf("Unable to process PROJECT_PATH argument value %v: %v", targetPath, err)
+			}
+			if !targetPathExists {
+				return fmt.Errorf("PROJECT_PATH argument %v does not exist", targetPath)
+			}
+			if targetPath.IsNotDir() && archive.HasValidExtension(targetPath) {
+				// The extracted archive is a temporary copy, so fixes would be lost and changes to the archive would not be seen.
+				if fix {
+					return fmt.Errorf("--fix flag is not supported for PROJECT_PATH argument archive %v", projectPath)
+				}
+				if watch {
+					return fmt.Errorf("--watch flag is not supported for PROJECT_PATH argument archive %v", projectPath)
+				}
+				// Release archives are linted as distributed, so the projects are found in the extracted archive.
+				targetPath, err = extractArchive(targetPath)
+				if err != nil {
+					return fmt.Errorf("Unable to extract PROJECT_PATH argument archive %v: %v", projectPath, err)
+				}
+			}
+			targetPaths.AddIfMissing(targetPath)
+		}
+	}
+
+	configurationFilePathString, _ := flags.GetString("config")
+	configurationFilePath, err = findConfigurationFile(configurationFilePathString, targetPaths)
+	if err != nil {
+		return err
+	}
+	var configurationFile configurationFileType
+	if configurationFilePath != nil {
+		configurationFile, err = loadConfigurationFile(configurationFilePath)
+		if err != nil {
+			return err
+		}
+	}
+	ruleSettings = configurationFile.Rules
+
+	// Command line flags take precedence over the configuration file.
+	complianceString := flagOrConfigurationFileString(flags, "compliance", configurationFile.Compliance)
 	if complianceString != "" {
 		customRuleModes[rulemode.Strict], customRuleModes[rulemode.Specification], customRuleModes[rulemode.Permissive], err = rulemode.ComplianceModeFromString(complianceString)
 		if err != nil {
@@ -43,13 +133,27 @@ func Initialize(flags *pflag.FlagSet, projectPaths []string) error {
 		}
 	}
 
+	failOnString := flagOrConfigurationFileString(flags, "fail-on", configurationFile.FailOn)
+	failOn, err = failon.FromString(failOnString)
+	if err != nil {
+		return fmt.Errorf("--fail-on flag value %s not valid", failOnString)
+	}
+
+	maxWarnings, _ = flags.GetInt("max-warnings")
+	if !flags.Changed("max-warnings") && configurationFile.MaxWarnings != nil {
+		maxWarnings = *configurationFile.MaxWarnings
+	}
+	if maxWarnings < -1 {
+		return fmt.Errorf("--max-warnings flag value %v not valid", maxWarnings)
+	}
+
 	outputFormatString, _ := flags.GetString("format")
 	outputFormat, err = outputformat.FromString(outputFormatString)
 	if err != nil {
 		return fmt.Errorf("--format flag value %s not valid", outputFormatString)
 	}
 
-	libraryManagerModeString, _ := flags.GetString("library-manager")
+	libraryManagerModeString := flagOrConfigurationFileString(flags, "library-manager", configurationFile.LibraryManager)
 	if libraryManagerModeString != "" {
 		customRuleModes[rulemode.LibraryManagerSubmission], customRuleModes[rulemode.LibraryManagerIndexed], err = rulemode.LibraryManagerModeFromString(libraryManagerModeString)
 		if err != nil {
@@ -57,6 +161,34 @@ func Initialize(flags *pflag.FlagSet, projectPaths []string) error {
 		}
 	}
 
+	libraryIndex, _ = flags.GetString("library-index")
+	if libraryIndex == "" {
+		libraryIndex = defaultLibraryIndex
+	} else if !strings.HasPrefix(libraryIndex, "http://") && !strings.HasPrefix(libraryIndex, "https://") {
+		libraryIndexExists, err := paths.New(libraryIndex).ExistCheck()
+		if err != nil {
+			return fmt.Errorf("Unable to process --library-index flag value %s: %v", libraryIndex, err)
+		}
+		if !libraryIndexExists {
+			return fmt.Errorf("--library-index flag value %s does not exist", libraryIndex)
+		}
+	}
+
+	packageIndexPathString, _ := flags.GetString("package-index")
+	packageIndexPath = paths.New(packageIndexPathString)
+	if packageIndexPath != nil {
+		packageIndexPathExists, err := packageIndexPath.ExistCheck()
+		if err != nil {
+			return fmt.Errorf("Unable to process --package-index flag value %s: %v", packageIndexPathString, err)
+		}
+		if !packageIndexPathExists {
+			return fmt.Errorf("--package-index flag value %s does not exist", packageIndexPathString)
+		}
+		if packageIndexPath.IsDir() {
+			return fmt.Errorf("--package-index flag value %s is not a file", packageIndexPathString)
+		}
+	}
+
 	if logFormatString, ok := os.LookupEnv("ARDUINO_LINT_LOG_FORMAT"); ok {
 		logFormat, err := logFormatFromString(logFormatString)
 		if err != nil {
@@ -75,13 +207,39 @@ func Initialize(flags *pflag.FlagSet, projectPaths []string) error {
 		EnableLogging(true)
 	}
 
-	superprojectTypeFilterString, _ := flags.GetString("project-type")
+	jobs, _ = flags.GetInt("jobs")
+	if jobs < 0 {
+		return fmt.Errorf("--jobs flag value %v not valid", jobs)
+	}
+	if jobs == 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	dryRun, _ = flags.GetBool("dry-run")
+	if dryRun && !fix {
+		return fmt.Errorf("--dry-run flag requires the --fix flag")
+	}
+	if dryRun && outputFormat != outputformat.Text && outputFormat != outputformat.JSON {
+		// The other formats have no place for the proposed changes.
+		return fmt.Errorf("--dry-run flag requires --format text or json")
+	}
+
+	offline, _ = flags.GetBool("offline")
+
+	if err := initializeRuleSelection(flags); err != nil {
+		return err
+	}
+
+	superprojectTypeFilterString := flagOrConfigurationFileString(flags, "project-type", configurationFile.ProjectType)
 	superprojectTypeFilter, err = projecttype.FromString(superprojectTypeFilterString)
 	if err != nil {
 		return fmt.Errorf("--project-type flag value %s not valid", superprojectTypeFilterString)
 	}
 
 	recursive, _ = flags.GetBool("recursive")
+	if !flags.Changed("recursive") && configurationFile.Recursive != nil {
+		recursive = *configurationFile.Recursive
+	}
 
 	reportFilePathString, _ := flags.GetString("report-file")
 	reportFilePath = paths.New(reportFilePathString)
@@ -90,28 +248,13 @@ func Initialize(flags *pflag.FlagSet, projectPaths []string) error {
 
 	versionMode, _ = flags.GetBool("version")
 
-	targetPaths = nil
-	if len(projectPaths) == 0 {
-		// Default to using current working directory.
-		workingDirectoryPath, err := os.Getwd()
-		if err != nil {
-			panic(err)
-		}
-		targetPaths.Add(paths.New(workingDirectoryPath))
-	} else {
-		for _, projectPath := range projectPaths {
-			targetPath := paths.New(projectPath)
-			targetPathExists, err := targetPath.ExistCheck()
-			if err != nil {
-				return fmt.Errorf("Unable to process PROJECT_PATH argument value %v: %v", targetPath, err)
-			}
-			if !targetPathExists {
-				return fmt.Errorf("PROJECT_PATH argument %v does not exist", targetPath)
-			}
-			targetPaths.AddIfMissing(targetPath)
-		}
+	if watch && outputFormat != outputformat.Text {
+		return fmt.Errorf("--watch flag requires --format text")
 	}
 
+	writeBaselinePathString, _ := flags.GetString("write-baseline")
+	writeBaselinePath = paths.New(writeBaselinePathString)
+
 	if officialModeString, ok := os.LookupEnv("ARDUINO_LINT_OFFICIAL"); ok {
 		customRuleModes[rulemode.Official], err = strconv.ParseBool(officialModeString)
 		if err != nil {
@@ -120,15 +263,32 @@ func Initialize(flags *pflag.FlagSet, projectPaths []string) error {
 	}
 
 	logrus.WithFields(logrus.Fields{
+		"archives folder":                 ArchivesPath(),
+		"baseline file":                   BaselinePath(),
+		"cache folder":                    CachePath(),
+		"configuration file":              ConfigurationFilePath(),
 		"compliance":                      rulemode.Compliance(customRuleModes),
+		"fail on":                         FailOn(),
+		"max warnings":                    MaxWarnings(),
 		"output format":                   OutputFormat(),
 		"Library Manager submission mode": customRuleModes[rulemode.LibraryManagerSubmission],
 		"Library Manager update mode":     customRuleModes[rulemode.LibraryManagerIndexed],
+		"Library Manager index":           LibraryIndex(),
+		"package index":                   PackageIndexPath(),
 		"log level":                       logrus.GetLevel().String(),
+		"jobs":                            Jobs(),
+		"fix":                             Fix(),
+		"dry run":                         DryRun(),
+		"offline":                         Offline(),
 		"superproject type filter":        SuperprojectTypeFilter(),
 		"recursive":                       Recursive(),
+		"rules":                           selectedRulePatterns,
+		"skipped rules":                   skippedRulePatterns,
+		"categories":                      selectedCategories,
 		"report file":                     ReportFilePath(),
 		"verbose":                         Verbose(),
+		"watch":                           Watch(),
+		"write baseline file":             WriteBaselinePath(),
 		"projects path":                   TargetPaths(),
 	}).Debug("Configuration initialized")
 
@@ -151,9 +311,64 @@ var customRuleModes = make(map[rulemode.Type]bool)
 
 // RuleModes returns the rule modes configuration for the given project type.
 func RuleModes(superprojectType projecttype.Type) map[rulemode.Type]bool {
+	return RuleModesWith(customRuleModes, superprojectType)
+}
+
+// RuleModesWith returns the rule modes configuration for the given project type, with the given rule mode settings in
+// place of the defaults.
+func RuleModesWith(customRuleModes map[rulemode.Type]bool, superprojectType projecttype.Type) map[rulemode.Type]bool {
 	return rulemode.Modes(defaultRuleModes, customRuleModes, superprojectType)
 }
 
+var libraryIndex string
+
+// LibraryIndex returns the path or URL of the Library Manager index.
+func LibraryIndex() string {
+	return libraryIndex
+}
+
+var jobs int
+
+// Jobs returns the number of projects to lint concurrently.
+func Jobs() int {
+	return jobs
+}
+
+var fix bool
+
+// Fix returns whether the mechanically fixable problems in the projects should be corrected.
+func Fix() bool {
+	return fix
+}
+
+var dryRun bool
+
+// DryRun returns whether the fixes should only be printed as a diff rather than written.
+func DryRun() bool {
+	return dryRun
+}
+
+var offline bool
+
+// Offline returns whether network access is disabled.
+func Offline() bool {
+	return offline
+}
+
+var failOn failon.Type
+
+// FailOn returns the lowest level of rule violation that causes a failure result.
+func FailOn() failon.Type {
+	return failOn
+}
+
+var maxWarnings int
+
+// MaxWarnings returns the number of warnings above which the result is a failure. -1 if there is no limit.
+func MaxWarnings() int {
+	return maxWarnings
+}
+
 var superprojectTypeFilter projecttype.Type
 
 // SuperprojectTypeFilter returns the superproject type filter configuration.
@@ -182,6 +397,41 @@ func ReportFilePath() *paths.Path {
 	return reportFilePath
 }
 
+var archivesPath *paths.Path
+
+// ArchivesPath returns the path of the folder containing the release archives referenced by package indexes, or nil if not specified.
+func ArchivesPath() *paths.Path {
+	return archivesPath
+}
+
+var packageIndexPath *paths.Path
+
+// PackageIndexPath returns the path of the package index file platforms are verified against, or nil if not specified.
+func PackageIndexPath() *paths.Path {
+	return packageIndexPath
+}
+
+var baselinePath *paths.Path
+
+// BaselinePath returns the path of the baseline file of previously recorded rule violations to suppress.
+func BaselinePath() *paths.Path {
+	return baselinePath
+}
+
+var cachePath *paths.Path
+
+// CachePath returns the path of the folder for cached network data, or nil if caching is disabled.
+func CachePath() *paths.Path {
+	return cachePath
+}
+
+var writeBaselinePath *paths.Path
+
+// WriteBaselinePath returns the path to save a baseline file of the current rule violations at.
+func WriteBaselinePath() *paths.Path {
+	return writeBaselinePath
+}
+
 var verbose bool
 
 // Verbose returns the verbosity setting.
@@ -189,6 +439,13 @@ func Verbose() bool {
 	return verbose
 }
 
+var watch bool
+
+// Watch returns whether to keep running and re-lint the projects when their files change.
+func Watch() bool {
+	return watch
+}
+
 var versionMode bool
 
 // VersionMode returns the --version setting.
@@ -224,6 +481,50 @@ func TargetPaths() paths.PathList {
 	return targetPaths
 }
 
+// archiveExtractionType is the temporary folder a PROJECT_PATH argument archive was extracted to.
+type archiveExtractionType struct {
+	archivePath    *paths.Path
+	extractionPath *paths.Path
+}
+
+var archiveExtractions []archiveExtractionType
+
+// extractArchive extracts the release archive to a temporary folder and returns the path of its root folder.
+func extractArchive(archivePath *paths.Path) (*paths.Path, error) {
+	extractionPath, err := paths.MkTempDir("", "arduino-lint-archive")
+	if err != nil {
+		return nil, err
+	}
+	archiveExtractions = append(archiveExtractions, archiveExtractionType{archivePath: archivePath, extractionPath: extractionPath})
+
+	return archive.Extract(archivePath, extractionPath)
+}
+
+// ReportPath returns the path to use for the given project path in the output.
+// Paths in an extracted PROJECT_PATH argument archive are returned relative to the archive (e.g., `Foo-1.0.0.zip/Foo`)
+// because the temporary folder is removed when Arduino Lint exits. Other paths are returned unchanged.
+func ReportPath(path *paths.Path) *paths.Path {
+	for _, extraction := range archiveExtractions {
+		if isInside, _ := path.IsInsideDir(extraction.extractionPath); isInside {
+			relativePath, err := path.RelFrom(extraction.extractionPath)
+			if err == nil {
+				return extraction.archivePath.Join(relativePath.String())
+			}
+		}
+	}
+	return path
+}
+
+// RemoveArchiveExtractions removes the temporary folders the archive PROJECT_PATH arguments were extracted to.
+func RemoveArchiveExtractions() {
+	for _, extraction := range archiveExtractions {
+		if err := extraction.extractionPath.RemoveAll(); err != nil {
+			logrus.Warnf("Unable to remove temporary folder %s: %v", extraction.extractionPath, err)
+		}
+	}
+	archiveExtractions = nil
+}
+
 // EnableLogging enables or disables logging debug output.
 func EnableLogging(enable bool) {
 	if enable {
@@ -16,9 +16,13 @@
 package configuration
 
 import (
+	"archive/zip"
+	"fmt"
 	"os"
+	"runtime"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration/failon"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/result/outputformat"
@@ -78,6 +82,36 @@ func TestInitializeFormat(t *testing.T) {
 	assert.Equal(t, outputformat.JSON, OutputFormat())
 }
 
+func TestInitializeFailOn(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, failon.Error, FailOn(), "Default")
+
+	flags.Set("fail-on", "warning")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, failon.Warning, FailOn())
+
+	flags.Set("fail-on", "never")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, failon.Never, FailOn())
+
+	flags.Set("fail-on", "foo")
+	assert.Error(t, Initialize(flags, projectPaths))
+}
+
+func TestInitializeMaxWarnings(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, -1, MaxWarnings(), "Default to no limit")
+
+	flags.Set("max-warnings", "0")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, 0, MaxWarnings())
+
+	flags.Set("max-warnings", "-2")
+	assert.Error(t, Initialize(flags, projectPaths))
+}
+
 func TestInitializeLibraryManager(t *testing.T) {
 	flags := test.ConfigurationFlags()
 	flags.Set("library-manager", "foo")
@@ -217,6 +251,56 @@ func TestInitializeProjectPath(t *testing.T) {
 	assert.Error(t, Initialize(test.ConfigurationFlags(), []string{"/nonexistent"}))
 }
 
+func TestInitializeProjectPathArchive(t *testing.T) {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-configuration-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+
+	writeZip := func(archivePath *paths.Path, fileNames ...string) {
+		archiveFile, err := archivePath.Create()
+		require.Nil(t, err)
+		defer archiveFile.Close()
+		zipWriter := zip.NewWriter(archiveFile)
+		for _, fileName := range fileNames {
+			_, err := zipWriter.Create(fileName)
+			require.Nil(t, err)
+		}
+		require.Nil(t, zipWriter.Close())
+	}
+
+	archivePath := temporaryPath.Join("Foo-1.0.0.zip")
+	writeZip(archivePath, "Foo/library.properties")
+	require.Nil(t, Initialize(test.ConfigurationFlags(), []string{archivePath.String()}))
+	require.Len(t, TargetPaths(), 1)
+	extractedPath := TargetPaths()[0]
+	assert.Equal(t, "Foo", extractedPath.Base(), "Archive root folder")
+	assert.True(t, extractedPath.Join("library.properties").Exist())
+	assert.Equal(t, archivePath.Join("Foo").String(), ReportPath(extractedPath).String(), "Path in archive")
+	assert.Equal(t, archivePath.Join("Foo", "examples").String(), ReportPath(extractedPath.Join("examples")).String(), "Path in archive")
+	assert.Equal(t, temporaryPath.String(), ReportPath(temporaryPath).String(), "Path outside archive")
+	assert.Nil(t, ConfigurationFilePath())
+
+	RemoveArchiveExtractions()
+	assert.False(t, extractedPath.Exist())
+
+	writeZip(archivePath, "Foo/library.properties", "Foo/.arduino-lint.yml")
+	require.Nil(t, Initialize(test.ConfigurationFlags(), []string{archivePath.String()}))
+	assert.Equal(t, TargetPaths()[0].Join(".arduino-lint.yml"), ConfigurationFilePath(), "Discover configuration file in archive root folder")
+	RemoveArchiveExtractions()
+
+	flags := test.ConfigurationFlags()
+	flags.Set("fix", "true")
+	assert.Error(t, Initialize(flags, []string{archivePath.String()}), "--fix not supported for archives")
+	flags = test.ConfigurationFlags()
+	flags.Set("watch", "true")
+	assert.Error(t, Initialize(flags, []string{archivePath.String()}), "--watch not supported for archives")
+	RemoveArchiveExtractions()
+
+	writeZip(archivePath, "Foo/library.properties", "Bar/library.properties")
+	assert.Error(t, Initialize(test.ConfigurationFlags(), []string{archivePath.String()}), "Multiple root folders")
+	RemoveArchiveExtractions()
+}
+
 func TestInitializeOfficial(t *testing.T) {
 	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths))
 	assert.False(t, customRuleModes[rulemode.Official], "Default official rule mode")
@@ -233,6 +317,21 @@ func TestInitializeOfficial(t *testing.T) {
 	assert.Error(t, Initialize(test.ConfigurationFlags(), projectPaths))
 }
 
+func TestRuleModesWith(t *testing.T) {
+	customRuleModes := map[rulemode.Type]bool{rulemode.Strict: true, rulemode.Specification: false, rulemode.Permissive: false}
+	libraryRuleModes := RuleModesWith(customRuleModes, projecttype.Library)
+	assert.True(t, libraryRuleModes[rulemode.Strict])
+	assert.False(t, libraryRuleModes[rulemode.Specification])
+	assert.True(t, libraryRuleModes[rulemode.LibraryManagerSubmission], "Default for the project type")
+	assert.False(t, RuleModesWith(customRuleModes, projecttype.Sketch)[rulemode.LibraryManagerSubmission], "Default for the project type")
+
+	customRuleModes = map[rulemode.Type]bool{rulemode.LibraryManagerSubmission: false, rulemode.LibraryManagerIndexed: true}
+	libraryRuleModes = RuleModesWith(customRuleModes, projecttype.Library)
+	assert.True(t, libraryRuleModes[rulemode.LibraryManagerIndexed])
+	assert.False(t, libraryRuleModes[rulemode.LibraryManagerSubmission])
+	assert.True(t, libraryRuleModes[rulemode.Specification], "Default for the project type")
+}
+
 func TestVersion(t *testing.T) {
 	version = "42.1.2"
 	assert.Equal(t, version, Version())
@@ -247,3 +346,294 @@ func TestBuildTimestamp(t *testing.T) {
 	buildTimestamp = "2020-11-27T04:05:19+00:00"
 	assert.Equal(t, buildTimestamp, BuildTimestamp())
 }
+
+func TestInitializeConfigurationFile(t *testing.T) {
+	os.Unsetenv("ARDUINO_LINT_OFFICIAL")
+	testDataPath, err := paths.New("testdata").Abs()
+	require.Nil(t, err)
+
+	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths))
+	assert.Nil(t, ConfigurationFilePath(), "No configuration file in project")
+	assert.False(t, RuleDisabled("LP012"))
+	_, ok := RuleLevelOverride("LS001")
+	assert.False(t, ok)
+
+	configurationFileProjectPath := testDataPath.Join("configuration-file")
+	require.Nil(t, Initialize(test.ConfigurationFlags(), []string{configurationFileProjectPath.String()}))
+	assert.Equal(t, configurationFileProjectPath.Join(".arduino-lint.yml"), ConfigurationFilePath(), "Discover configuration file in project root")
+	assert.True(t, customRuleModes[rulemode.Strict])
+	assert.True(t, customRuleModes[rulemode.LibraryManagerIndexed])
+	assert.Equal(t, failon.Warning, FailOn())
+	assert.Equal(t, 3, MaxWarnings())
+	assert.Equal(t, projecttype.Library, SuperprojectTypeFilter())
+	assert.False(t, Recursive())
+	assert.True(t, RuleDisabled("LP012"))
+	assert.False(t, RuleDisabled("LS001"))
+	levelOverride, ok := RuleLevelOverride("LS001")
+	assert.True(t, ok)
+	assert.Equal(t, "WARNING", levelOverride)
+	_, ok = RuleLevelOverride("LP012")
+	assert.False(t, ok)
+
+	flags := test.ConfigurationFlags()
+	flags.Set("compliance", "permissive")
+	flags.Set("recursive", "true")
+	flags.Set("max-warnings", "-1")
+	require.Nil(t, Initialize(flags, []string{configurationFileProjectPath.String()}))
+	assert.True(t, customRuleModes[rulemode.Permissive], "Flags take precedence over configuration file")
+	assert.Equal(t, -1, MaxWarnings(), "Flags take precedence over configuration file")
+	assert.True(t, Recursive(), "Flags take precedence over configuration file")
+
+	flags = test.ConfigurationFlags()
+	flags.Set("config", configurationFileProjectPath.Join(".arduino-lint.yml").String())
+	require.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, configurationFileProjectPath.Join(".arduino-lint.yml"), ConfigurationFilePath(), "Use configuration file from --config flag")
+	assert.True(t, RuleDisabled("LP012"))
+
+	flags.Set("config", testDataPath.Join("nonexistent.yml").String())
+	assert.Error(t, Initialize(flags, projectPaths))
+
+	otherProjectPath, err := paths.MkTempDir("", "arduino-lint-configuration-test")
+	require.Nil(t, err)
+	defer otherProjectPath.RemoveAll()
+	require.Nil(t, configurationFileProjectPath.Join(".arduino-lint.yml").CopyTo(otherProjectPath.Join(".arduino-lint.yml")))
+	require.Nil(t, Initialize(test.ConfigurationFlags(), []string{configurationFileProjectPath.String(), otherProjectPath.String()}), "Identical configuration files")
+	assert.Equal(t, configurationFileProjectPath.Join(".arduino-lint.yml"), ConfigurationFilePath())
+	require.Nil(t, otherProjectPath.Join(".arduino-lint.yml").WriteFile([]byte("compliance: permissive\n")))
+	assert.Error(t, Initialize(test.ConfigurationFlags(), []string{configurationFileProjectPath.String(), otherProjectPath.String()}), "Conflicting configuration files")
+	flags = test.ConfigurationFlags()
+	flags.Set("config", otherProjectPath.Join(".arduino-lint.yml").String())
+	require.Nil(t, Initialize(flags, []string{configurationFileProjectPath.String(), otherProjectPath.String()}), "--config flag resolves conflict")
+	assert.True(t, customRuleModes[rulemode.Permissive])
+
+	assert.Error(t, Initialize(test.ConfigurationFlags(), []string{testDataPath.Join("unknown-key").String()}), "Unknown key")
+	assert.Error(t, Initialize(test.ConfigurationFlags(), []string{testDataPath.Join("invalid-rule-setting").String()}), "Invalid rule setting")
+
+	SetRuleIDs([]string{"LP012", "LS001"})
+	defer SetRuleIDs(nil)
+	assert.Nil(t, Initialize(test.ConfigurationFlags(), []string{configurationFileProjectPath.String()}), "Rule IDs are case insensitive")
+	assert.True(t, RuleDisabled("LP012"))
+	assert.Error(t, Initialize(test.ConfigurationFlags(), []string{testDataPath.Join("unknown-rule-id").String()}), "Unknown rule ID")
+}
+
+func TestInitializeLibraryIndex(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, defaultLibraryIndex, LibraryIndex(), "Default to official index")
+
+	flags.Set("library-index", "https://example.com/library_index.json")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, "https://example.com/library_index.json", LibraryIndex())
+
+	flags.Set("library-index", projectPaths[0])
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, projectPaths[0], LibraryIndex())
+
+	flags.Set("library-index", "/nonexistent/library_index.json")
+	assert.Error(t, Initialize(flags, projectPaths), "Local index must exist")
+}
+
+func TestInitializeArchivesDir(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Nil(t, ArchivesPath(), "Default to not verifying archives")
+
+	flags.Set("archives-dir", projectPaths[0])
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, paths.New(projectPaths[0]), ArchivesPath())
+
+	flags.Set("archives-dir", paths.New(projectPaths[0], "configuration.go").String())
+	assert.Error(t, Initialize(flags, projectPaths), "Must be a folder")
+
+	flags.Set("archives-dir", "/nonexistent")
+	assert.Error(t, Initialize(flags, projectPaths), "Must exist")
+}
+
+func TestInitializePackageIndex(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Nil(t, PackageIndexPath(), "Default to not verifying platforms against a package index")
+
+	packageIndexPath := paths.New(projectPaths[0], "configuration.go")
+	flags.Set("package-index", packageIndexPath.String())
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, packageIndexPath, PackageIndexPath())
+
+	flags.Set("package-index", projectPaths[0])
+	assert.Error(t, Initialize(flags, projectPaths), "Must be a file")
+
+	flags.Set("package-index", "/nonexistent")
+	assert.Error(t, Initialize(flags, projectPaths), "Must exist")
+}
+
+func TestInitializeFix(t *testing.T) {
+	flags := test.ConfigurationFlags()
+
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.False(t, Fix())
+	assert.False(t, DryRun())
+
+	flags.Set("fix", "true")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.True(t, Fix())
+	assert.False(t, DryRun())
+
+	flags.Set("dry-run", "true")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.True(t, Fix())
+	assert.True(t, DryRun())
+
+	flags.Set("format", "json")
+	assert.Nil(t, Initialize(flags, projectPaths), "JSON report includes the proposed changes")
+	for _, format := range []string{"sarif", "junit", "github-actions"} {
+		flags.Set("format", format)
+		assert.Error(t, Initialize(flags, projectPaths), "--dry-run not supported with --format %s", format)
+	}
+	flags.Set("format", "text")
+
+	flags.Set("fix", "false")
+	assert.Error(t, Initialize(flags, projectPaths), "--dry-run requires --fix")
+}
+
+func TestInitializeOffline(t *testing.T) {
+	flags := test.ConfigurationFlags()
+
+	flags.Set("offline", "true")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.True(t, Offline())
+
+	flags.Set("offline", "false")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.False(t, Offline())
+}
+
+func TestInitializeWatch(t *testing.T) {
+	flags := test.ConfigurationFlags()
+
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.False(t, Watch())
+
+	flags.Set("watch", "true")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.True(t, Watch())
+
+	flags.Set("format", "json")
+	assert.Error(t, Initialize(flags, projectPaths), "--watch requires text output format")
+}
+
+func TestInitializeCache(t *testing.T) {
+	flags := test.ConfigurationFlags()
+
+	flags.Set("no-cache", "true")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Nil(t, CachePath(), "Caching disabled")
+
+	flags.Set("no-cache", "false")
+	flags.Set("cache-dir", "/foo/cache")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, paths.New("/foo/cache"), CachePath())
+
+	flags.Set("cache-dir", "")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	userCachePath, err := os.UserCacheDir()
+	if err == nil {
+		assert.Equal(t, paths.New(userCachePath, "arduino-lint"), CachePath(), "Default to user cache folder")
+	}
+}
+
+func TestInitializeJobs(t *testing.T) {
+	flags := test.ConfigurationFlags()
+
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, runtime.NumCPU(), Jobs(), "Default to number of CPUs")
+
+	flags.Set("jobs", "3")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, 3, Jobs())
+
+	flags.Set("jobs", "-1")
+	assert.Error(t, Initialize(flags, projectPaths))
+}
+
+func TestInitializeRuleSelection(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.True(t, RuleSelected("LP001", "library.properties", "general"), "All rules are selected by default")
+
+	flags.Set("rules", "LP0*,ls001")
+	flags.Set("skip-rules", "LP012")
+	flags.Set("category", "library.properties,structure")
+	assert.Nil(t, Initialize(flags, projectPaths))
+	assert.Equal(t, []string{"LP0*", "ls001"}, selectedRulePatterns)
+	assert.Equal(t, []string{"LP012"}, skippedRulePatterns)
+	assert.Equal(t, []string{"library.properties", "structure"}, selectedCategories)
+
+	flags = test.ConfigurationFlags()
+	flags.Set("rules", "LP[0")
+	assert.Error(t, Initialize(flags, projectPaths), "Invalid glob")
+
+	flags = test.ConfigurationFlags()
+	flags.Set("skip-rules", "LP[0")
+	assert.Error(t, Initialize(flags, projectPaths), "Invalid glob")
+
+	SetRuleIDs([]string{"LP012", "LS001"})
+	defer SetRuleIDs(nil)
+	SetRuleCategories([]string{"library.properties", "name field", "structure", "miscellaneous"})
+	defer SetRuleCategories(nil)
+
+	flags = test.ConfigurationFlags()
+	flags.Set("rules", "lp0*,LS")
+	flags.Set("skip-rules", "LP012")
+	flags.Set("category", "Library.properties,name field")
+	assert.Nil(t, Initialize(flags, projectPaths), "Patterns and categories matching known rules")
+
+	flags = test.ConfigurationFlags()
+	flags.Set("rules", "LP012,LP9")
+	assert.Error(t, Initialize(flags, projectPaths), "Prefix matching no rule")
+
+	flags = test.ConfigurationFlags()
+	flags.Set("skip-rules", "LP0?")
+	assert.Error(t, Initialize(flags, projectPaths), "Glob matching no rule")
+
+	flags = test.ConfigurationFlags()
+	flags.Set("category", "library.propertys")
+	assert.Error(t, Initialize(flags, projectPaths), "Unknown category")
+}
+
+func TestRuleSelected(t *testing.T) {
+	testTables := []struct {
+		rules             []string
+		skipRules         []string
+		categories        []string
+		ruleID            string
+		selectedAssertion assert.BoolAssertionFunc
+	}{
+		{nil, nil, nil, "LP012", assert.True},
+		{[]string{"LP012"}, nil, nil, "LP012", assert.True},
+		{[]string{"lp012"}, nil, nil, "LP012", assert.True},
+		{[]string{"LP013"}, nil, nil, "LP012", assert.False},
+		{[]string{"LP"}, nil, nil, "LP012", assert.True},
+		{[]string{"LP0"}, nil, nil, "LS001", assert.False},
+		{[]string{"LP01?"}, nil, nil, "LP012", assert.True},
+		{[]string{"LP01?"}, nil, nil, "LP022", assert.False},
+		{[]string{"LS*", "LP*"}, nil, nil, "LP012", assert.True},
+		{nil, []string{"LP01*"}, nil, "LP012", assert.False},
+		{nil, []string{"LP01*"}, nil, "LP022", assert.True},
+		{[]string{"LP"}, []string{"LP012"}, nil, "LP012", assert.False},
+		{nil, nil, []string{"Library.properties"}, "LP012", assert.True},
+		{nil, nil, []string{"name field"}, "LP012", assert.True},
+		{nil, nil, []string{"structure"}, "LP012", assert.False},
+		{[]string{"LS"}, nil, []string{"library.properties"}, "LP012", assert.False},
+	}
+
+	for _, testTable := range testTables {
+		selectedRulePatterns = testTable.rules
+		skippedRulePatterns = testTable.skipRules
+		selectedCategories = testTable.categories
+		testTable.selectedAssertion(t, RuleSelected(testTable.ruleID, "library.properties", "name field"), fmt.Sprintf("%s with rules: %v, skip rules: %v, categories: %v", testTable.ruleID, testTable.rules, testTable.skipRules, testTable.categories))
+	}
+
+	selectedRulePatterns = nil
+	skippedRulePatterns = nil
+	selectedCategories = nil
+}
@@ -0,0 +1,216 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+// Support for the project configuration file.
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration/failon"
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// configurationFileNames are the supported file names of the configuration file, in order of precedence.
+var configurationFileNames = []string{".arduino-lint.yml", ".arduino-lint.yaml"}
+
+// Valid values for the per-rule settings of the configuration file.
+const (
+	ruleSettingDisable = "disable"
+	ruleSettingInfo    = "info"
+	ruleSettingWarning = "warning"
+	ruleSettingError   = "error"
+)
+
+// configurationFileType is the type for the data of the configuration file.
+// The settings are equivalent to the command line flags of the same names.
+type configurationFileType struct {
+	Compliance     string            `yaml:"compliance"`
+	FailOn         string            `yaml:"fail-on"`
+	LibraryManager string            `yaml:"library-manager"`
+	MaxWarnings    *int              `yaml:"max-warnings"`
+	ProjectType    string            `yaml:"project-type"`
+	Recursive      *bool             `yaml:"recursive"`
+	Rules          map[string]string `yaml:"rules"`
+}
+
+// findConfigurationFile returns the path of the configuration file to use.
+// The --config flag takes precedence. Otherwise, the configuration file found in the root of the given target paths (the
+// extracted root of archive PROJECT_PATH arguments) is used. The configuration applies to all projects, so an error is
+// returned if the target paths have configuration files which differ.
+// nil is returned if there is no configuration file.
+func findConfigurationFile(configurationFilePathString string, targetPaths paths.PathList) (*paths.Path, error) {
+	if configurationFilePathString != "" {
+		configurationFilePath := paths.New(configurationFilePathString)
+		if configurationFilePath.IsDir() || !configurationFilePath.Exist() {
+			return nil, fmt.Errorf("--config flag value %s is not a file", configurationFilePathString)
+		}
+		return configurationFilePath, nil
+	}
+
+	var foundConfigurationFilePath *paths.Path
+	var foundConfigurationFileData []byte
+	for _, targetPath := range targetPaths {
+		projectRootPath := targetPath
+		if projectRootPath.IsNotDir() {
+			projectRootPath = projectRootPath.Parent()
+		}
+		for _, configurationFileName := range configurationFileNames {
+			configurationFilePath := projectRootPath.Join(configurationFileName)
+			if !configurationFilePath.Exist() || configurationFilePath.IsDir() {
+				continue
+			}
+
+			configurationFileData, err := configurationFilePath.ReadFile()
+			if err != nil {
+				return nil, fmt.Errorf("Unable to read configuration file %s: %v", ReportPath(configurationFilePath), err)
+			}
+			if foundConfigurationFilePath == nil {
+				foundConfigurationFilePath = configurationFilePath
+				foundConfigurationFileData = configurationFileData
+			} else if !bytes.Equal(configurationFileData, foundConfigurationFileData) {
+				return nil, fmt.Errorf("Configuration files %s and %s differ. The configuration applies to all PROJECT_PATH arguments, so use the --config flag to specify which one to use", ReportPath(foundConfigurationFilePath), ReportPath(configurationFilePath))
+			}
+			break
+		}
+	}
+
+	return foundConfigurationFilePath, nil
+}
+
+// loadConfigurationFile parses and validates the configuration file at the given path.
+func loadConfigurationFile(configurationFilePath *paths.Path) (configurationFileType, error) {
+	var configurationFile configurationFileType
+	reportPath := ReportPath(configurationFilePath) // The configuration file may be in an extracted archive.
+
+	configurationFileData, err := configurationFilePath.ReadFile()
+	if err != nil {
+		return configurationFile, fmt.Errorf("Unable to read configuration file %s: %v", reportPath, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(configurationFileData))
+	decoder.KnownFields(true) // Typos in the configuration file should not be silently ignored.
+	if err := decoder.Decode(&configurationFile); err != nil && err != io.EOF {
+		return configurationFile, fmt.Errorf("Unable to parse configuration file %s: %v", reportPath, err)
+	}
+
+	if configurationFile.Compliance != "" {
+		if _, _, _, err := rulemode.ComplianceModeFromString(configurationFile.Compliance); err != nil {
+			return configurationFile, fmt.Errorf("compliance value %s in configuration file %s not valid", configurationFile.Compliance, reportPath)
+		}
+	}
+
+	if configurationFile.FailOn != "" {
+		if _, err := failon.FromString(configurationFile.FailOn); err != nil {
+			return configurationFile, fmt.Errorf("fail-on value %s in configuration file %s not valid", configurationFile.FailOn, reportPath)
+		}
+	}
+
+	if configurationFile.LibraryManager != "" {
+		if _, _, err := rulemode.LibraryManagerModeFromString(configurationFile.LibraryManager); err != nil {
+			return configurationFile, fmt.Errorf("library-manager value %s in configuration file %s not valid", configurationFile.LibraryManager, reportPath)
+		}
+	}
+
+	if configurationFile.MaxWarnings != nil && *configurationFile.MaxWarnings < -1 {
+		return configurationFile, fmt.Errorf("max-warnings value %v in configuration file %s not valid", *configurationFile.MaxWarnings, reportPath)
+	}
+
+	if configurationFile.ProjectType != "" {
+		if _, err := projecttype.FromString(configurationFile.ProjectType); err != nil {
+			return configurationFile, fmt.Errorf("project-type value %s in configuration file %s not valid", configurationFile.ProjectType, reportPath)
+		}
+	}
+
+	normalizedRules := make(map[string]string, len(configurationFile.Rules))
+	for ruleID, ruleSetting := range configurationFile.Rules {
+		normalizedRuleID := strings.ToUpper(ruleID)
+		if knownRuleIDs != nil && !knownRuleIDs[normalizedRuleID] {
+			return configurationFile, fmt.Errorf("Unknown rule %s in configuration file %s", ruleID, reportPath)
+		}
+
+		normalizedRuleSetting := strings.ToLower(ruleSetting)
+		switch normalizedRuleSetting {
+		case ruleSettingDisable, ruleSettingInfo, ruleSettingWarning, ruleSettingError:
+			normalizedRules[normalizedRuleID] = normalizedRuleSetting
+		default:
+			return configurationFile, fmt.Errorf("Setting %s for rule %s in configuration file %s not valid. Can be {disable|info|warning|error}", ruleSetting, ruleID, reportPath)
+		}
+	}
+	configurationFile.Rules = normalizedRules
+
+	return configurationFile, nil
+}
+
+// flagOrConfigurationFileString returns the value of the given string flag, unless the flag was not set and the configuration file provides a value.
+func flagOrConfigurationFileString(flags *pflag.FlagSet, flagName string, configurationFileValue string) string {
+	flagValue, _ := flags.GetString(flagName)
+	if !flags.Changed(flagName) && configurationFileValue != "" {
+		return configurationFileValue
+	}
+	return flagValue
+}
+
+var configurationFilePath *paths.Path
+
+// ConfigurationFilePath returns the path of the configuration file in use, or nil if there is none.
+func ConfigurationFilePath() *paths.Path {
+	return configurationFilePath
+}
+
+var knownRuleIDs map[string]bool
+
+// SetRuleIDs sets the IDs of the existing rules, which the rule IDs in the configuration file and the --rules and
+// --skip-rules flag values are validated against.
+// The rule configurations can't be imported by this package, so the IDs are provided by the rule package.
+// If not set, the rule IDs are not validated.
+func SetRuleIDs(ruleIDs []string) {
+	if ruleIDs == nil {
+		knownRuleIDs = nil
+		return
+	}
+
+	knownRuleIDs = make(map[string]bool, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		knownRuleIDs[strings.ToUpper(ruleID)] = true
+	}
+}
+
+var ruleSettings map[string]string
+
+// RuleDisabled returns whether the configuration file disables the rule of the given ID.
+func RuleDisabled(ruleID string) bool {
+	return ruleSettings[strings.ToUpper(ruleID)] == ruleSettingDisable
+}
+
+// RuleLevelOverride returns the level string ("INFO", "WARNING", or "ERROR") the configuration file forces on failures of the rule of the given ID.
+// The second return value is false if the level of the rule is not overridden.
+func RuleLevelOverride(ruleID string) (string, bool) {
+	ruleSetting := ruleSettings[strings.ToUpper(ruleID)]
+	switch ruleSetting {
+	case ruleSettingInfo, ruleSettingWarning, ruleSettingError:
+		return strings.ToUpper(ruleSetting), true
+	default:
+		return "", false
+	}
+}
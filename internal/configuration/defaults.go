@@ -62,3 +62,6 @@ var defaultRuleModes = map[projecttype.Type]map[rulemode.Type]bool{
 }
 
 var defaultLogOutput = os.Stderr
+
+// defaultLibraryIndex is the URL of the Library Manager index.
+const defaultLibraryIndex = "http://downloads.arduino.cc/libraries/library_index.json"
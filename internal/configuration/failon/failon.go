@@ -0,0 +1,48 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package failon defines the rule violation levels which cause a failure result.
+package failon
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Type is the type for the --fail-on settings.
+//go:generate stringer -type=Type -linecomment
+type Type int
+
+const (
+	Error   Type = iota // error
+	Warning             // warning
+	Info                // info
+	Never               // never
+)
+
+// FromString parses the --fail-on flag value and returns the corresponding fail on setting.
+func FromString(failOnString string) (Type, error) {
+	failOn, found := map[string]Type{
+		Error.String():   Error,
+		Warning.String(): Warning,
+		Info.String():    Info,
+		Never.String():   Never,
+	}[strings.ToLower(failOnString)]
+
+	if found {
+		return failOn, nil
+	}
+	return Error, fmt.Errorf("No matching fail on setting for string %s", failOnString)
+}
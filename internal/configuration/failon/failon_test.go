@@ -0,0 +1,45 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package failon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromString(t *testing.T) {
+	testTables := []struct {
+		failOnString   string
+		expectedFailOn Type
+		errorAssertion assert.ErrorAssertionFunc
+	}{
+		{"error", Error, assert.NoError},
+		{"warning", Warning, assert.NoError},
+		{"info", Info, assert.NoError},
+		{"never", Never, assert.NoError},
+		{"WARNING", Warning, assert.NoError},
+		{"foo", 0, assert.Error},
+	}
+
+	for _, testTable := range testTables {
+		failOn, err := FromString(testTable.failOnString)
+		testTable.errorAssertion(t, err, testTable.failOnString)
+		if err == nil {
+			assert.Equal(t, testTable.expectedFailOn, failOn, testTable.failOnString)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+// Support for selecting the rules to run.
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+var selectedRulePatterns []string
+var skippedRulePatterns []string
+var selectedCategories []string
+
+// initializeRuleSelection parses the rule selection flags.
+func initializeRuleSelection(flags *pflag.FlagSet) error {
+	var err error
+
+	selectedRulePatterns, err = ruleIDPatterns(flags, "rules")
+	if err != nil {
+		return err
+	}
+
+	skippedRulePatterns, err = ruleIDPatterns(flags, "skip-rules")
+	if err != nil {
+		return err
+	}
+
+	selectedCategories, _ = flags.GetStringSlice("category")
+	for _, category := range selectedCategories {
+		if knownRuleCategories != nil && !knownRuleCategories[strings.ToLower(category)] {
+			return fmt.Errorf("--category flag value %s not valid", category)
+		}
+	}
+
+	return nil
+}
+
+// ruleIDPatterns returns the validated rule ID patterns of the given flag.
+func ruleIDPatterns(flags *pflag.FlagSet, flagName string) ([]string, error) {
+	patterns, _ := flags.GetStringSlice(flagName)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("--%s flag value %s not valid: %v", flagName, pattern, err)
+		}
+
+		// A typo in a pattern would otherwise silently select or skip nothing.
+		if knownRuleIDs == nil {
+			continue
+		}
+		matched := false
+		for ruleID := range knownRuleIDs {
+			if matchesRuleIDPattern(ruleID, []string{pattern}) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("--%s flag value %s matches no rule", flagName, pattern)
+		}
+	}
+
+	return patterns, nil
+}
+
+var knownRuleCategories map[string]bool
+
+// SetRuleCategories sets the categories and subcategories of the existing rules, which the --category flag values are
+// validated against. If not set, the --category flag values are not validated.
+func SetRuleCategories(categories []string) {
+	if categories == nil {
+		knownRuleCategories = nil
+		return
+	}
+
+	knownRuleCategories = make(map[string]bool, len(categories))
+	for _, category := range categories {
+		knownRuleCategories[strings.ToLower(category)] = true
+	}
+}
+
+// RuleSelected returns whether the rule of the given ID, category, and subcategory is selected by the --rules,
+// --skip-rules, and --category flags.
+func RuleSelected(ruleID string, category string, subcategory string) bool {
+	if len(selectedRulePatterns) > 0 && !matchesRuleIDPattern(ruleID, selectedRulePatterns) {
+		return false
+	}
+
+	if matchesRuleIDPattern(ruleID, skippedRulePatterns) {
+		return false
+	}
+
+	if len(selectedCategories) == 0 {
+		return true
+	}
+	for _, selectedCategory := range selectedCategories {
+		if strings.EqualFold(selectedCategory, category) || strings.EqualFold(selectedCategory, subcategory) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesRuleIDPattern returns whether the rule ID matches any of the patterns.
+// A pattern is either a glob (e.g., `PF05?`) or a prefix of the ID (e.g., `LP0`, which also matches the exact ID).
+func matchesRuleIDPattern(ruleID string, patterns []string) bool {
+	ruleID = strings.ToUpper(ruleID)
+	for _, pattern := range patterns {
+		pattern = strings.ToUpper(pattern)
+		if strings.ContainsAny(pattern, `*?[\`) {
+			if matched, _ := path.Match(pattern, ruleID); matched {
+				return true
+			}
+		} else if strings.HasPrefix(ruleID, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package change defines the changes made to a project by the fixes.
+package change
+
+// Kinds of changes.
+const (
+	Edit   = "edit"   // The content of a file was changed.
+	Rename = "rename" // A file or folder was renamed.
+)
+
+// Type is the type for the changes made to projects by the fixes.
+type Type struct {
+	Kind        string // Can be {edit|rename}.
+	Path        string // Slash-separated path of the file or folder, relative to the project path.
+	NewPath     string // Slash-separated new path of a renamed file or folder, relative to the project path. Empty for edits.
+	Description string
+}
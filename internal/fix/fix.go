@@ -0,0 +1,191 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package fix corrects the mechanically fixable problems in projects.
+package fix
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/fix/change"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/library/libraryproperties"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result"
+	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/arduino/arduino-lint/internal/util/diff"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// Projects corrects the mechanically fixable problems in the given projects and records the changes in the results.
+// In dry run mode, the changes are printed instead of being made.
+// The projects are returned with their paths updated for any renamed folders.
+func Projects(projects []project.Type) ([]project.Type, error) {
+	fixedProjects := append([]project.Type{}, projects...)
+	for index := range fixedProjects {
+		var changes []change.Type
+		var diff string
+		var err error
+		switch fixedProjects[index].ProjectType {
+		case projecttype.Sketch:
+			changes, err = fixSketch(fixedProjects[index])
+		case projecttype.Library:
+			changes, diff, err = fixLibrary(fixedProjects[index])
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Results.RecordChanges(fixedProjects[index], changes, diff)
+
+		if !configuration.DryRun() {
+			// Subprojects may be in a renamed folder.
+			for _, projectChange := range changes {
+				if projectChange.Kind == change.Rename {
+					rebaseProjects(fixedProjects[index+1:], fixedProjects[index].Path.Join(projectChange.Path), fixedProjects[index].Path.Join(projectChange.NewPath))
+				}
+			}
+		}
+	}
+
+	return fixedProjects, nil
+}
+
+// fixSketch corrects the mechanically fixable problems in the given sketch project.
+func fixSketch(project project.Type) ([]change.Type, error) {
+	changes, err := fixPdeExtensions(project)
+	if err != nil {
+		return nil, err
+	}
+
+	srcChanges, err := fixFileNames(project, sketchRenameFixes)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(changes, srcChanges...), nil
+}
+
+// fixLibrary corrects the mechanically fixable problems in the given library project.
+// In dry run mode, the unified diff of the edits is also returned.
+func fixLibrary(project project.Type) ([]change.Type, string, error) {
+	changes, err := fixFileNames(project, libraryRenameFixes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The metadata file name must be fixed first, so that its content can be fixed.
+	libraryPropertiesPath := project.Path.Join("library.properties")
+	for _, renameChange := range changes {
+		if renameChange.Kind == change.Rename && renameChange.NewPath == "library.properties" && configuration.DryRun() {
+			libraryPropertiesPath = project.Path.Join(renameChange.Path)
+		}
+	}
+
+	editChanges, diff, err := fixLibraryProperties(project, libraryPropertiesPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return append(changes, editChanges...), diff, nil
+}
+
+// fixLibraryProperties corrects the mechanically fixable problems in the given library.properties file of the library project.
+// In dry run mode, the file is not written and the unified diff of the edits is returned instead.
+func fixLibraryProperties(project project.Type, libraryPropertiesPath *paths.Path) ([]change.Type, string, error) {
+	if libraryPropertiesPath.NotExist() {
+		return nil, "", nil
+	}
+
+	data, err := libraryPropertiesPath.ReadFile()
+	if err != nil {
+		return nil, "", fmt.Errorf("Unable to read %s: %v", libraryPropertiesPath, err)
+	}
+
+	libraryProperties, err := properties.LoadFromBytes(data)
+	if err != nil {
+		// The rules will report the problem with the file.
+		return nil, "", nil
+	}
+
+	fixedData, fixes := libraryproperties.Fix(libraryProperties, data)
+	if len(fixes) == 0 {
+		return nil, "", nil
+	}
+
+	relativePath := projectRelativePath(project, libraryPropertiesPath)
+	changes := []change.Type{}
+	for _, fix := range fixes {
+		changes = append(changes, change.Type{Kind: change.Edit, Path: relativePath, Description: fix})
+	}
+
+	if configuration.DryRun() {
+		unifiedDiff := diff.Unified(displayPath(libraryPropertiesPath), data, fixedData)
+		feedback.Print(unifiedDiff)
+		return changes, unifiedDiff, nil
+	}
+
+	for _, fix := range fixes {
+		feedback.Printf("Fixed %s: %s\n", libraryPropertiesPath, fix)
+	}
+	if err := libraryPropertiesPath.WriteFile(fixedData); err != nil {
+		return nil, "", fmt.Errorf("Unable to write %s: %v", libraryPropertiesPath, err)
+	}
+
+	return changes, "", nil
+}
+
+// rebaseProjects updates the paths of the projects which are under the renamed folder.
+func rebaseProjects(projects []project.Type, oldPath *paths.Path, newPath *paths.Path) {
+	for index := range projects {
+		relativePath, err := projects[index].Path.RelFrom(oldPath)
+		if err != nil || strings.HasPrefix(relativePath.String(), "..") {
+			continue
+		}
+
+		projects[index].Path = newPath.JoinPath(relativePath)
+	}
+}
+
+// projectRelativePath returns the slash-separated path of the given path, relative to the project.
+func projectRelativePath(project project.Type, path *paths.Path) string {
+	relativePath, err := path.RelFrom(project.Path)
+	if err != nil {
+		return filepath.ToSlash(path.String())
+	}
+
+	return filepath.ToSlash(relativePath.String())
+}
+
+// displayPath returns the slash-separated path relative to the working directory, so the diff can be applied with
+// `git apply` or `patch -p1`.
+func displayPath(path *paths.Path) string {
+	workingDirectoryPath, err := os.Getwd()
+	if err != nil {
+		return filepath.ToSlash(path.String())
+	}
+
+	relativePath, err := path.RelFrom(paths.New(workingDirectoryPath))
+	if err != nil {
+		return filepath.ToSlash(path.String())
+	}
+
+	return filepath.ToSlash(relativePath.String())
+}
@@ -0,0 +1,209 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package fix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/fix/change"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataPath *paths.Path
+
+func init() {
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	testDataPath = paths.New(workingDirectory, "testdata")
+}
+
+// copyTestLibrary returns a project for a temporary copy of the test library of the given name.
+func copyTestLibrary(t *testing.T, libraryName string) project.Type {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-fix-test")
+	require.Nil(t, err)
+	t.Cleanup(func() { temporaryPath.RemoveAll() })
+
+	libraryPath := temporaryPath.Join(libraryName)
+	require.Nil(t, testDataPath.Join(libraryName).CopyDirTo(libraryPath))
+
+	return project.Type{
+		Path:             libraryPath,
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+}
+
+// initializeConfiguration initializes the configuration for fixing the given project.
+func initializeConfiguration(t *testing.T, fixedProject project.Type, dryRun bool) {
+	flags := test.ConfigurationFlags()
+	flags.Set("fix", "true")
+	flags.Set("dry-run", fmt.Sprint(dryRun))
+	require.Nil(t, configuration.Initialize(flags, []string{fixedProject.Path.String()}))
+	result.Results.Initialize()
+}
+
+func TestProjects(t *testing.T) {
+	library := copyTestLibrary(t, "Fixable")
+	example := project.Type{
+		Path:             library.Path.Join("Examples", "Foo"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Library,
+	}
+	originalData, err := library.Path.Join("library.properties").ReadFile()
+	require.Nil(t, err)
+
+	initializeConfiguration(t, library, true)
+	fixedProjects, err := Projects([]project.Type{library, example})
+	require.Nil(t, err)
+	assert.Equal(t, []project.Type{library, example}, fixedProjects, "Dry run doesn't change the project paths")
+	data, err := library.Path.Join("library.properties").ReadFile()
+	require.Nil(t, err)
+	assert.Equal(t, originalData, data, "Dry run doesn't modify the file")
+	assert.True(t, library.Path.Join("Examples", "Foo", "Foo.pde").Exist(), "Dry run doesn't rename")
+	require.Len(t, result.Results.Projects, 2)
+	assert.Contains(t, recordedChanges(0), changeSummary{false, change.Rename, "Examples", "examples"})
+
+	initializeConfiguration(t, library, false)
+	fixedProjects, err = Projects([]project.Type{library, example})
+	require.Nil(t, err)
+	assert.Equal(t, library.Path.Join("examples", "Foo"), fixedProjects[1].Path, "Subproject paths are updated")
+	assert.True(t, library.Path.Join("examples", "Foo", "Foo.ino").Exist(), "Subprojects are fixed after renaming")
+	assert.True(t, library.Path.Join("extras", "README.md").Exist())
+	assertBaseNames(t, library.Path, "examples", "extras", "library.properties", "src")
+	require.Len(t, result.Results.Projects, 2)
+	assert.Contains(t, recordedChanges(0), changeSummary{true, change.Rename, "Examples", "examples"})
+	assert.Contains(t, recordedChanges(0), changeSummary{true, change.Rename, "Extra", "extras"})
+	assert.Contains(t, recordedChanges(1), changeSummary{true, change.Rename, "Foo.pde", "Foo.ino"})
+
+	data, err = library.Path.Join("library.properties").ReadFile()
+	require.Nil(t, err)
+	assert.Equal(t,
+		`name=Fixable
+version=1.0.0
+author=Cristian Maglie <c.maglie@example.com>, Pippo Pluto <pippo@example.com>
+# The maintainer field was formerly named email.
+maintainer=Cristian Maglie <c.maglie@example.com>
+sentence=A library that makes coding a web server a breeze.
+paragraph=Supports HTTP1.1 and you can do GET and POST.
+category=Communication
+url=http://example.com/
+architectures=avr
+dot_a_linkage=true
+`,
+		string(data),
+	)
+}
+
+func TestProjectsMisnamedMetadata(t *testing.T) {
+	library := copyTestLibrary(t, "MisnamedMetadata")
+
+	initializeConfiguration(t, library, true)
+	_, err := Projects([]project.Type{library})
+	require.Nil(t, err)
+	assertBaseNames(t, library.Path, "Library.properties", "src")
+	require.Len(t, result.Results.Projects, 1)
+	assert.Contains(t, recordedChanges(0), changeSummary{false, change.Rename, "Library.properties", "library.properties"})
+	assert.Contains(t, recordedChanges(0), changeSummary{false, change.Edit, "Library.properties", ""}, "Dry run fixes the content of the misnamed file")
+	assert.Contains(t, result.Results.Projects[0].Diff, "+++ b/", "Dry run records the diff of the edits")
+
+	initializeConfiguration(t, library, false)
+	_, err = Projects([]project.Type{library})
+	require.Nil(t, err)
+	assertBaseNames(t, library.Path, "library.properties", "src")
+	assert.Contains(t, recordedChanges(0), changeSummary{true, change.Edit, "library.properties", ""})
+	assert.Empty(t, result.Results.Projects[0].Diff, "Diff is only recorded in dry run mode")
+}
+
+func TestProjectsSketch(t *testing.T) {
+	sketch := copyTestLibrary(t, "PdeSketch")
+	sketch.ProjectType = projecttype.Sketch
+	sketch.SuperprojectType = projecttype.Sketch
+
+	initializeConfiguration(t, sketch, false)
+	_, err := Projects([]project.Type{sketch})
+	require.Nil(t, err)
+	assertBaseNames(t, sketch.Path, "PdeSketch.ino", "src")
+	assert.True(t, sketch.Path.Join("src", "Foo.h").Exist())
+}
+
+func TestProjectsNonLibrary(t *testing.T) {
+	nonLibrary := copyTestLibrary(t, "Fixable")
+	nonLibrary.ProjectType = projecttype.Platform
+	originalData, err := nonLibrary.Path.Join("library.properties").ReadFile()
+	require.Nil(t, err)
+
+	initializeConfiguration(t, nonLibrary, false)
+	_, err = Projects([]project.Type{nonLibrary})
+	require.Nil(t, err)
+	data, err := nonLibrary.Path.Join("library.properties").ReadFile()
+	require.Nil(t, err)
+	assert.Equal(t, originalData, data, "Only library projects are fixed")
+	assertBaseNames(t, nonLibrary.Path, "Examples", "Extra", "library.properties", "src")
+}
+
+func TestRename(t *testing.T) {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-fix-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+
+	require.Nil(t, temporaryPath.Join("Src").MkdirAll())
+	require.Nil(t, temporaryPath.Join("Src.arduino-lint-rename-0").MkdirAll())
+	require.Nil(t, rename(temporaryPath.Join("Src"), temporaryPath.Join("src")), "Case-only rename")
+	assertBaseNames(t, temporaryPath, "Src.arduino-lint-rename-0", "src")
+
+	require.Nil(t, temporaryPath.Join("extras").MkdirAll())
+	assert.Error(t, rename(temporaryPath.Join("src"), temporaryPath.Join("extras")), "Existing path is not overwritten")
+}
+
+// changeSummary is the type for summaries of the recorded changes, without the descriptions.
+type changeSummary struct {
+	applied bool
+	kind    string
+	path    string
+	newPath string
+}
+
+// recordedChanges returns summaries of the changes recorded in the results for the project at the given index.
+func recordedChanges(projectIndex int) []changeSummary {
+	changes := []changeSummary{}
+	for _, projectChange := range result.Results.Projects[projectIndex].Changes {
+		changes = append(changes, changeSummary{projectChange.Applied, projectChange.Kind, projectChange.Path, projectChange.NewPath})
+	}
+
+	return changes
+}
+
+// assertBaseNames asserts that the base names of the contents of the folder are the given names.
+func assertBaseNames(t *testing.T, folderPath *paths.Path, baseNames ...string) {
+	listing, err := folderPath.ReadDir()
+	require.Nil(t, err)
+	listedBaseNames := []string{}
+	for _, path := range listing {
+		listedBaseNames = append(listedBaseNames, path.Base())
+	}
+	assert.ElementsMatch(t, baseNames, listedBaseNames)
+}
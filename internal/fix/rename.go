@@ -0,0 +1,202 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package fix
+
+// The fixes for misnamed files and folders.
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/fix/change"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/library"
+	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/arduino/go-paths-helper"
+)
+
+// renameFixType is the type for the definitions of the fixes for misnamed files and folders in the project root.
+type renameFixType struct {
+	correctBaseName   string
+	folder            bool           // Whether the fix is for a folder rather than a file.
+	misspellingRegexp *regexp.Regexp // Misspellings of the name, in addition to incorrect case. nil if only the case is fixed.
+	applies           func(project project.Type) bool
+}
+
+// sketchRenameFixes are the rename fixes for sketch projects.
+var sketchRenameFixes = []renameFixType{
+	{correctBaseName: "src", folder: true}, // SS005
+}
+
+// libraryRenameFixes are the rename fixes for library projects.
+var libraryRenameFixes = []renameFixType{
+	{ // LS009
+		correctBaseName: "src",
+		folder:          true,
+		applies: func(project project.Type) bool {
+			// The src folder has no special treatment in the flat layout.
+			return !(library.ContainsMetadataFile(project.Path) && library.ContainsHeaderFile(project.Path))
+		},
+	},
+	{ // LD005, LD006
+		correctBaseName:   "examples",
+		folder:            true,
+		misspellingRegexp: regexp.MustCompile("(?i)^e((x)|(xs)|(s))((am)|(ma))p((le)|(el))s?$"),
+	},
+	{ // LS011, LS012
+		correctBaseName:   "extras",
+		folder:            true,
+		misspellingRegexp: regexp.MustCompile("(?i)^extra$"),
+	},
+	{ // LP002, LP003
+		correctBaseName:   "library.properties",
+		misspellingRegexp: regexp.MustCompile("(?i)^librar((y)|(ie))s?[.-_]?propert((y)|(ie))s?$"),
+	},
+}
+
+// fixFileNames renames the misnamed files and folders in the root of the given project.
+func fixFileNames(project project.Type, renameFixes []renameFixType) ([]change.Type, error) {
+	changes := []change.Type{}
+	for _, renameFix := range renameFixes {
+		if renameFix.applies != nil && !renameFix.applies(project) {
+			continue
+		}
+
+		directoryListing, err := project.Path.ReadDir()
+		if err != nil {
+			return nil, err
+		}
+		if renameFix.folder {
+			directoryListing.FilterDirs()
+		} else {
+			directoryListing.FilterOutDirs()
+		}
+
+		misnamedPath := findMisnamedPath(directoryListing, renameFix)
+		if misnamedPath == nil {
+			continue
+		}
+
+		renameChange, err := renamePath(project, misnamedPath, misnamedPath.Parent().Join(renameFix.correctBaseName))
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, renameChange)
+	}
+
+	return changes, nil
+}
+
+// findMisnamedPath returns the path from the list that should be renamed according to the fix, or nil if there is none.
+func findMisnamedPath(pathList paths.PathList, renameFix renameFixType) *paths.Path {
+	for _, path := range pathList {
+		if path.Base() == renameFix.correctBaseName {
+			// Renaming would overwrite the correctly named path.
+			return nil
+		}
+	}
+
+	// A path with incorrect case is preferred, since renaming a misspelled path would overwrite it on case-insensitive filesystems.
+	for _, path := range pathList {
+		if strings.EqualFold(path.Base(), renameFix.correctBaseName) {
+			return path
+		}
+	}
+	for _, path := range pathList {
+		if renameFix.misspellingRegexp != nil && renameFix.misspellingRegexp.MatchString(path.Base()) {
+			return path
+		}
+	}
+
+	return nil
+}
+
+// fixPdeExtensions renames the files with the obsolete .pde extension in the root of the given sketch project to .ino.
+func fixPdeExtensions(project project.Type) ([]change.Type, error) {
+	directoryListing, err := project.Path.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	directoryListing.FilterOutDirs()
+
+	changes := []change.Type{}
+	for _, path := range directoryListing {
+		if path.Ext() != ".pde" {
+			continue
+		}
+
+		inoPath := path.Parent().Join(strings.TrimSuffix(path.Base(), path.Ext()) + ".ino")
+		if inoPath.Exist() {
+			// Renaming would overwrite the existing file.
+			continue
+		}
+
+		renameChange, err := renamePath(project, path, inoPath)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, renameChange)
+	}
+
+	return changes, nil
+}
+
+// renamePath renames the file or folder of the given project, unless in dry run mode, and returns the change.
+func renamePath(project project.Type, oldPath *paths.Path, newPath *paths.Path) (change.Type, error) {
+	renameChange := change.Type{
+		Kind:        change.Rename,
+		Path:        projectRelativePath(project, oldPath),
+		NewPath:     projectRelativePath(project, newPath),
+		Description: fmt.Sprintf("Renamed %s to %s", oldPath.Base(), newPath.Base()),
+	}
+
+	if configuration.DryRun() {
+		feedback.Printf("Would rename %s to %s\n", displayPath(oldPath), displayPath(newPath))
+		return renameChange, nil
+	}
+
+	if err := rename(oldPath, newPath); err != nil {
+		return change.Type{}, fmt.Errorf("Unable to rename %s to %s: %v", oldPath, newPath, err)
+	}
+	feedback.Printf("Fixed %s: %s\n", project.Path, renameChange.Description)
+
+	return renameChange, nil
+}
+
+// rename renames the file or folder.
+// Renames which only change the case of the name are done via a temporary name, since on case-insensitive filesystems
+// the new path is the same file as the old path.
+func rename(oldPath *paths.Path, newPath *paths.Path) error {
+	if !strings.EqualFold(oldPath.Base(), newPath.Base()) {
+		if newPath.Exist() {
+			return fmt.Errorf("%s already exists", newPath)
+		}
+		return oldPath.Rename(newPath)
+	}
+
+	var temporaryPath *paths.Path
+	for suffix := 0; temporaryPath == nil || temporaryPath.Exist(); suffix++ {
+		temporaryPath = oldPath.Parent().Join(fmt.Sprintf("%s.arduino-lint-rename-%d", oldPath.Base(), suffix))
+	}
+
+	if err := oldPath.Rename(temporaryPath); err != nil {
+		return err
+	}
+
+	return temporaryPath.Rename(newPath)
+}
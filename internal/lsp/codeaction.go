@@ -0,0 +1,102 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+// Quick fixes for the mechanically fixable problems, as corrected by the --fix flag.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/project/library/libraryproperties"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// codeActions returns the fixes for the problems in the given range of the document.
+func (server *Server) codeActions(params codeActionParamsType) []codeActionType {
+	codeActions := []codeActionType{}
+	documentPath, err := uriPath(params.TextDocument.URI)
+	if err != nil || documentPath.Base() != "library.properties" {
+		return codeActions // Only library.properties has autofixes.
+	}
+	text, ok := server.documents[params.TextDocument.URI]
+	if !ok {
+		return codeActions
+	}
+
+	libraryProperties, err := properties.LoadFromBytes([]byte(text))
+	if err != nil {
+		return codeActions // The diagnostics report the problem with the file.
+	}
+	fixedText, fixes := libraryproperties.Fix(libraryProperties, []byte(text))
+	if len(fixes) == 0 {
+		return codeActions
+	}
+
+	// The fixes don't add or remove lines, so each changed line is a separate fix.
+	lines := documentLines(text)
+	fixedLines := documentLines(string(fixedText))
+	if len(fixedLines) != len(lines) {
+		return codeActions
+	}
+	allEdits := []textEditType{}
+	for lineIndex := range lines {
+		if lines[lineIndex] == fixedLines[lineIndex] {
+			continue
+		}
+
+		edit := textEditType{
+			Range: rangeType{
+				Start: positionType{Line: lineIndex, Character: 0},
+				End:   positionType{Line: lineIndex, Character: utf16Length(lines[lineIndex])},
+			},
+			NewText: fixedLines[lineIndex],
+		}
+		allEdits = append(allEdits, edit)
+		if lineIndex < params.Range.Start.Line || lineIndex > params.Range.End.Line {
+			continue
+		}
+
+		_, lineFixes := libraryproperties.Fix(libraryProperties, []byte(lines[lineIndex]))
+		lineDiagnostics := []diagnosticType{}
+		for _, diagnostic := range params.Context.Diagnostics {
+			if diagnostic.Range.Start.Line == lineIndex {
+				lineDiagnostics = append(lineDiagnostics, diagnostic)
+			}
+		}
+		codeActions = append(
+			codeActions,
+			codeActionType{
+				Title:       strings.Join(lineFixes, ", "),
+				Kind:        quickFixCodeActionKind,
+				Diagnostics: lineDiagnostics,
+				IsPreferred: true,
+				Edit:        workspaceEditType{Changes: map[string][]textEditType{params.TextDocument.URI: {edit}}},
+			},
+		)
+	}
+
+	codeActions = append(
+		codeActions,
+		codeActionType{
+			Title: fmt.Sprintf("Fix all mechanically fixable problems in %s", documentPath.Base()),
+			Kind:  fixAllCodeActionKind,
+			Edit:  workspaceEditType{Changes: map[string][]textEditType{params.TextDocument.URI: allEdits}},
+		},
+	)
+
+	return codeActions
+}
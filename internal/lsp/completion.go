@@ -0,0 +1,122 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+// Completion of the property keys defined by the JSON schemas of the metadata files.
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/rule/schema"
+	"github.com/arduino/arduino-lint/internal/rule/schema/schemadata"
+)
+
+// propertiesSchemaFilenames maps the names of the properties metadata files to their JSON schema.
+var propertiesSchemaFilenames = map[string]string{
+	"library.properties": "arduino-library-properties-schema.json",
+	"boards.txt":         "arduino-boards-txt-schema.json",
+	"platform.txt":       "arduino-platform-txt-schema.json",
+	"programmers.txt":    "arduino-programmers-txt-schema.json",
+}
+
+// completion returns the property keys which complete the key being typed at the given position of the document.
+func (server *Server) completion(params completionParamsType) completionListType {
+	completionList := completionListType{Items: []completionItemType{}}
+	documentPath, err := uriPath(params.TextDocument.URI)
+	if err != nil {
+		return completionList
+	}
+	schemaFilename, ok := propertiesSchemaFilenames[documentPath.Base()]
+	if !ok {
+		return completionList
+	}
+	lines := documentLines(server.documents[params.TextDocument.URI])
+	if params.Position.Line >= len(lines) {
+		return completionList
+	}
+
+	lineText := lines[params.Position.Line]
+	typedText := lineText[:byteOffset(lineText, params.Position.Character)]
+	keyStartOffset := len(typedText) - len(strings.TrimLeft(typedText, " \t"))
+	typedKey := typedText[keyStartOffset:]
+	if strings.ContainsAny(typedKey, "=#") {
+		return completionList // A property value or comment is being typed.
+	}
+
+	if _, ok := server.propertyKeys[schemaFilename]; !ok {
+		server.propertyKeys[schemaFilename] = schema.PropertyKeys(schemaFilename, schemadata.Asset)
+	}
+	completions := make(map[string]bool)
+	for _, key := range server.propertyKeys[schemaFilename] {
+		if completion, ok := keyCompletion(key, typedKey); ok {
+			completions[completion] = true
+		}
+	}
+
+	keyRange := rangeType{
+		Start: positionType{Line: params.Position.Line, Character: utf16Length(typedText[:keyStartOffset])},
+		End:   params.Position,
+	}
+	for completion := range completions {
+		completionList.Items = append(
+			completionList.Items,
+			completionItemType{
+				Label:      completion,
+				Kind:       propertyCompletionItemKind,
+				FilterText: completion,
+				TextEdit:   textEditType{Range: keyRange, NewText: completion},
+			},
+		)
+	}
+	sort.Slice(completionList.Items, func(i, j int) bool { return completionList.Items[i].Label < completionList.Items[j].Label })
+
+	return completionList
+}
+
+// keyCompletion returns the completion of the typed key for the given key from the JSON schema. The segments of the key
+// which are defined by a pattern (e.g., the board ID) must already have been typed, and are taken from the typed key.
+func keyCompletion(key string, typedKey string) (string, bool) {
+	segments := strings.Split(key, ".")
+	lastPatternIndex := -1
+	for index, segment := range segments {
+		if segment == schema.PatternKeySegment {
+			lastPatternIndex = index
+		}
+	}
+	if lastPatternIndex == len(segments)-1 {
+		return "", false // There is nothing to complete after the pattern segment.
+	}
+	if lastPatternIndex < 0 {
+		return key, true
+	}
+
+	prefixPattern := "^"
+	for _, segment := range segments[:lastPatternIndex+1] {
+		if segment == schema.PatternKeySegment {
+			prefixPattern += `[^.=\s]+\.`
+		} else {
+			prefixPattern += regexp.QuoteMeta(segment) + `\.`
+		}
+	}
+	typedPrefix := regexp.MustCompile(prefixPattern).FindString(typedKey)
+	if typedPrefix == "" {
+		return "", false
+	}
+
+	return typedPrefix + strings.Join(segments[lastPatternIndex+1:], "."), true
+}
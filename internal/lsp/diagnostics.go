@@ -0,0 +1,326 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+// Linting of the documents open in the client.
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/packageindex"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result"
+	"github.com/arduino/arduino-lint/internal/rule"
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/go-paths-helper"
+)
+
+// metadataFileProjectTypes maps the names of the supported metadata files to the type of the project they belong to.
+// Package index files are recognized by their name pattern.
+var metadataFileProjectTypes = map[string]projecttype.Type{
+	"library.properties": projecttype.Library,
+	"boards.txt":         projecttype.Platform,
+	"platform.txt":       projecttype.Platform,
+	"programmers.txt":    projecttype.Platform,
+	"sketch.json":        projecttype.Sketch,
+}
+
+// lint runs the rules on the project of the document, using the text of the documents open in the client in place of
+// the files on disk, and returns the diagnostics of the project's open documents, by URI.
+func (server *Server) lint(uri string) (map[string][]diagnosticType, error) {
+	documentDiagnostics := map[string][]diagnosticType{uri: {}}
+	documentPath, err := uriPath(uri)
+	if err != nil {
+		return documentDiagnostics, nil // Not a file, so there is no project to lint.
+	}
+	lintedProject, ok := documentProject(documentPath)
+	if !ok {
+		return documentDiagnostics, nil
+	}
+
+	// The configuration file of the document's project applies, not the one in the working directory of the client.
+	if err := configuration.Initialize(server.flags, []string{lintedProject.Path.String()}); err != nil {
+		return nil, fmt.Errorf("Invalid configuration: %v", err)
+	}
+
+	overlayPath, err := paths.MkTempDir("", "arduino-lint-lsp")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create temporary folder: %v", err)
+	}
+	defer overlayPath.RemoveAll()
+	overlayProject, openDocuments, err := server.overlay(lintedProject, overlayPath.Join(lintedProject.Path.Base()))
+	if err != nil {
+		return nil, err
+	}
+
+	if lintedProject.ProjectType == projecttype.Library {
+		if err := projectdata.LoadLibraryManagerIndex(); err != nil {
+			return nil, err
+		}
+	}
+
+	var results result.Type
+	results.Initialize()
+	for _, ruleResult := range rule.Run(overlayProject) {
+		results.Record(overlayProject, ruleResult.Configuration, ruleResult.Result, ruleResult.Output, ruleResult.Locations)
+	}
+
+	for documentURI, relativePath := range openDocuments {
+		documentDiagnostics[documentURI] = diagnostics(results, lintedProject, overlayProject, relativePath, server.documents[documentURI])
+	}
+
+	return documentDiagnostics, nil
+}
+
+// overlay creates a view of the project at the given path, with the text of the open documents in place of the files
+// on disk. It returns the project of the view and the slash-separated project-relative paths of the open metadata files
+// of the project, by URI.
+func (server *Server) overlay(lintedProject project.Type, overlayProjectPath *paths.Path) (project.Type, map[string]string, error) {
+	overlayProject := lintedProject
+	overlayProject.Path = overlayProjectPath
+
+	basePath := lintedProject.Path
+	if lintedProject.ProjectType == projecttype.PackageIndex {
+		// Package index projects are files. Any other open package index in the folder is a different project.
+		basePath = basePath.Parent()
+	}
+
+	overlayDocuments := make(map[string][]byte)
+	openDocuments := make(map[string]string)
+	for documentURI, text := range server.documents {
+		documentPath, err := uriPath(documentURI)
+		if err != nil {
+			continue
+		}
+
+		if lintedProject.ProjectType == projecttype.PackageIndex {
+			if !documentPath.EquivalentTo(lintedProject.Path) {
+				continue
+			}
+			if err := overlayProjectPath.WriteFile([]byte(text)); err != nil {
+				return project.Type{}, nil, fmt.Errorf("Unable to write %s: %v", overlayProjectPath, err)
+			}
+		} else {
+			relativePath, err := documentPath.RelFrom(lintedProject.Path)
+			if err != nil || strings.HasPrefix(relativePath.String(), "..") {
+				continue
+			}
+			overlayDocuments[filepath.ToSlash(relativePath.String())] = []byte(text)
+		}
+
+		if openProject, ok := documentProject(documentPath); !ok || openProject.ProjectType != lintedProject.ProjectType || !openProject.Path.EquivalentTo(lintedProject.Path) {
+			continue // e.g., the metadata file of a library example sketch.
+		}
+		relativePath, err := documentPath.RelFrom(basePath)
+		if err != nil {
+			return project.Type{}, nil, err
+		}
+		openDocuments[documentURI] = filepath.ToSlash(relativePath.String())
+	}
+
+	if lintedProject.ProjectType != projecttype.PackageIndex {
+		if err := overlayFolder(lintedProject.Path, overlayProjectPath, overlayDocuments); err != nil {
+			return project.Type{}, nil, fmt.Errorf("Unable to create view of project %s: %v", lintedProject.Path, err)
+		}
+	}
+
+	return overlayProject, openDocuments, nil
+}
+
+// diagnostics returns the diagnostics of the document at the given project-relative path for the unsuppressed rule
+// violations in the results.
+func diagnostics(results result.Type, lintedProject project.Type, overlayProject project.Type, relativePath string, text string) []diagnosticType {
+	documentDiagnostics := []diagnosticType{}
+	if len(results.Projects) == 0 {
+		return documentDiagnostics
+	}
+
+	lines := documentLines(text)
+	for _, ruleReport := range results.Projects[0].Rules {
+		if ruleReport.Result != ruleresult.Fail.String() || ruleReport.Suppression != nil {
+			continue
+		}
+
+		diagnostic := diagnosticType{
+			Severity: severity(ruleReport.Level),
+			Code:     ruleReport.ID,
+			Source:   "arduino-lint",
+			// Messages may contain paths in the project copy.
+			Message: strings.ReplaceAll(ruleReport.Message, overlayProject.Path.String(), lintedProject.Path.String()),
+		}
+
+		if len(ruleReport.Locations) == 0 {
+			// Violations without a location are shown at the start of the metadata file they concern.
+			if lintedProject.ProjectType == projecttype.PackageIndex || ruleReport.Category == relativePath || ruleReport.Subcategory == relativePath {
+				diagnostic.Range = lineRange(lines, 0, 0)
+				documentDiagnostics = append(documentDiagnostics, diagnostic)
+			}
+			continue
+		}
+
+		for _, locationReport := range ruleReport.Locations {
+			if locationReport.Path != relativePath {
+				continue
+			}
+			diagnostic.Range = lineRange(lines, locationReport.Line, locationReport.Column)
+			documentDiagnostics = append(documentDiagnostics, diagnostic)
+		}
+	}
+
+	return documentDiagnostics
+}
+
+// severity returns the diagnostic severity for the rule level.
+func severity(level string) int {
+	switch level {
+	case rulelevel.Error.String():
+		return errorSeverity
+	case rulelevel.Warning.String():
+		return warningSeverity
+	default:
+		return informationSeverity
+	}
+}
+
+// uriPath returns the path of the file with the given URI.
+func uriPath(uri string) (*paths.Path, error) {
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if parsedURI.Scheme != "file" {
+		return nil, fmt.Errorf("URI scheme %s is not supported", parsedURI.Scheme)
+	}
+
+	filePath := parsedURI.Path
+	if runtime.GOOS == "windows" {
+		filePath = strings.TrimPrefix(filePath, "/") // e.g., /C:/foo
+	}
+
+	return paths.New(filepath.FromSlash(filePath)), nil
+}
+
+// documentProject returns the project of the metadata file at the given path, if it is a supported file.
+func documentProject(documentPath *paths.Path) (project.Type, bool) {
+	projectType, ok := metadataFileProjectTypes[documentPath.Base()]
+	projectPath := documentPath.Parent()
+	if !ok {
+		if !packageindex.HasValidFilename(documentPath, true) {
+			return project.Type{}, false
+		}
+		projectType = projecttype.PackageIndex
+		projectPath = documentPath
+	}
+
+	return project.Type{
+		Path:             projectPath,
+		ProjectType:      projectType,
+		SuperprojectType: projectType,
+	}, true
+}
+
+// overlayFolder creates a folder at overlayPath with the content of the folder at sourcePath, except for version control
+// data. The given documents, by slash-separated relative path, are written in place of the files on disk. So that the
+// whole project doesn't have to be copied for every change, the other files and folders are symbolic links to the
+// originals. Only the folders which contain documents are created.
+func overlayFolder(sourcePath *paths.Path, overlayPath *paths.Path, documents map[string][]byte) error {
+	if err := overlayPath.MkdirAll(); err != nil {
+		return err
+	}
+
+	subfolderDocuments := make(map[string]map[string][]byte)
+	for relativePath, data := range documents {
+		pathElements := strings.SplitN(relativePath, "/", 2)
+		if len(pathElements) == 1 {
+			if err := overlayPath.Join(relativePath).WriteFile(data); err != nil {
+				return err
+			}
+			continue
+		}
+		if subfolderDocuments[pathElements[0]] == nil {
+			subfolderDocuments[pathElements[0]] = make(map[string][]byte)
+		}
+		subfolderDocuments[pathElements[0]][pathElements[1]] = data
+	}
+	for subfolderName, documents := range subfolderDocuments {
+		if err := overlayFolder(sourcePath.Join(subfolderName), overlayPath.Join(subfolderName), documents); err != nil {
+			return err
+		}
+	}
+
+	if sourcePath.NotExist() {
+		return nil // The folder of a new document which was not saved yet.
+	}
+	sourceListing, err := sourcePath.ReadDir()
+	if err != nil {
+		return err
+	}
+	for _, sourceItemPath := range sourceListing {
+		overlayItemPath := overlayPath.Join(sourceItemPath.Base())
+		if sourceItemPath.Base() == ".git" || overlayItemPath.Exist() {
+			continue // Already created for the documents.
+		}
+		if err := os.Symlink(sourceItemPath.String(), overlayItemPath.String()); err == nil {
+			continue
+		}
+
+		// Creating symbolic links may not be permitted (e.g., on Windows), in which case a copy is made.
+		if sourceItemPath.IsDir() {
+			err = copyFolder(sourceItemPath, overlayItemPath)
+		} else {
+			err = sourceItemPath.CopyTo(overlayItemPath)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyFolder copies the folder recursively, except for version control data.
+func copyFolder(sourcePath *paths.Path, destinationPath *paths.Path) error {
+	return filepath.Walk(sourcePath.String(), func(walkPath string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fileInfo.IsDir() && fileInfo.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		relativePath, err := filepath.Rel(sourcePath.String(), walkPath)
+		if err != nil {
+			return err
+		}
+		copyPath := destinationPath.Join(relativePath)
+		if fileInfo.IsDir() {
+			return copyPath.MkdirAll()
+		}
+		if !fileInfo.Mode().IsRegular() {
+			return nil
+		}
+		return paths.New(walkPath).CopyTo(copyPath)
+	})
+}
@@ -0,0 +1,118 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+// The JSON-RPC 2.0 base protocol used by the Language Server Protocol.
+// See: https://microsoft.github.io/language-server-protocol/specifications/specification-3-16/#baseProtocol
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// JSON-RPC error codes.
+const (
+	parseError           = -32700
+	invalidRequest       = -32600
+	methodNotFound       = -32601
+	invalidParams        = -32602
+	serverNotInitialized = -32002
+)
+
+// requestMessageType is the type of the request and notification messages received from the client.
+type requestMessageType struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"` // Absent for notifications.
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification returns whether the message is a notification, to which no response is sent.
+func (request requestMessageType) isNotification() bool {
+	return len(request.ID) == 0
+}
+
+// responseMessageType is the type of the response messages sent to the client.
+type responseMessageType struct {
+	JSONRPC string             `json:"jsonrpc"`
+	ID      json.RawMessage    `json:"id"`
+	Result  json.RawMessage    `json:"result,omitempty"`
+	Error   *responseErrorType `json:"error,omitempty"`
+}
+
+// responseErrorType is the type of the error of a failed request.
+type responseErrorType struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// notificationMessageType is the type of the notification messages sent to the client.
+type notificationMessageType struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+// readMessage reads the content of the next message from the reader.
+func readMessage(reader *bufio.Reader) ([]byte, error) {
+	contentLength := -1
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		header = strings.TrimRight(header, "\r\n")
+		if header == "" {
+			break // The header part ends with an empty line.
+		}
+
+		headerFields := strings.SplitN(header, ":", 2)
+		if len(headerFields) == 2 && strings.EqualFold(strings.TrimSpace(headerFields[0]), "Content-Length") {
+			contentLength, err = strconv.Atoi(strings.TrimSpace(headerFields[1]))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid Content-Length header: %s", header)
+			}
+		}
+	}
+	if contentLength < 0 {
+		return nil, fmt.Errorf("Message has no Content-Length header")
+	}
+
+	content := make([]byte, contentLength)
+	if _, err := io.ReadFull(reader, content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// writeMessage writes the message to the writer.
+func writeMessage(writer io.Writer, message interface{}) error {
+	content, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(writer, "Content-Length: %d\r\n\r\n", len(content)); err != nil {
+		return err
+	}
+	_, err = writer.Write(content)
+	return err
+}
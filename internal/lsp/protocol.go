@@ -0,0 +1,166 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+// The subset of the Language Server Protocol types used by the server.
+// See: https://microsoft.github.io/language-server-protocol/specifications/specification-3-16/
+
+// Diagnostic severities.
+const (
+	errorSeverity       = 1
+	warningSeverity     = 2
+	informationSeverity = 3
+)
+
+// fullTextDocumentSync is the text document sync kind where the client sends the full text on every change.
+const fullTextDocumentSync = 1
+
+// propertyCompletionItemKind is the kind of the completion items for property keys.
+const propertyCompletionItemKind = 10
+
+// Code action kinds.
+const (
+	quickFixCodeActionKind = "quickfix"
+	fixAllCodeActionKind   = "source.fixAll"
+)
+
+// messageType of the window/logMessage notifications.
+const errorMessageType = 1
+
+type positionType struct {
+	Line      int `json:"line"`      // 0-based.
+	Character int `json:"character"` // 0-based offset in UTF-16 code units.
+}
+
+type rangeType struct {
+	Start positionType `json:"start"`
+	End   positionType `json:"end"`
+}
+
+type textDocumentIdentifierType struct {
+	URI string `json:"uri"`
+}
+
+type textDocumentItemType struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+type diagnosticType struct {
+	Range    rangeType `json:"range"`
+	Severity int       `json:"severity"`
+	Code     string    `json:"code"`
+	Source   string    `json:"source"`
+	Message  string    `json:"message"`
+}
+
+type textEditType struct {
+	Range   rangeType `json:"range"`
+	NewText string    `json:"newText"`
+}
+
+type workspaceEditType struct {
+	Changes map[string][]textEditType `json:"changes"`
+}
+
+type codeActionType struct {
+	Title       string            `json:"title"`
+	Kind        string            `json:"kind"`
+	Diagnostics []diagnosticType  `json:"diagnostics,omitempty"`
+	IsPreferred bool              `json:"isPreferred,omitempty"`
+	Edit        workspaceEditType `json:"edit"`
+}
+
+type completionItemType struct {
+	Label      string       `json:"label"`
+	Kind       int          `json:"kind"`
+	FilterText string       `json:"filterText"`
+	TextEdit   textEditType `json:"textEdit"`
+}
+
+type completionListType struct {
+	IsIncomplete bool                 `json:"isIncomplete"`
+	Items        []completionItemType `json:"items"`
+}
+
+type initializeResultType struct {
+	Capabilities serverCapabilitiesType `json:"capabilities"`
+	ServerInfo   serverInfoType         `json:"serverInfo"`
+}
+
+type serverCapabilitiesType struct {
+	TextDocumentSync   textDocumentSyncOptionsType `json:"textDocumentSync"`
+	CodeActionProvider codeActionOptionsType       `json:"codeActionProvider"`
+	CompletionProvider completionOptionsType       `json:"completionProvider"`
+}
+
+type textDocumentSyncOptionsType struct {
+	OpenClose bool `json:"openClose"`
+	Change    int  `json:"change"`
+}
+
+type codeActionOptionsType struct {
+	CodeActionKinds []string `json:"codeActionKinds"`
+}
+
+type completionOptionsType struct {
+	TriggerCharacters []string `json:"triggerCharacters"`
+}
+
+type serverInfoType struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type didOpenTextDocumentParamsType struct {
+	TextDocument textDocumentItemType `json:"textDocument"`
+}
+
+type didChangeTextDocumentParamsType struct {
+	TextDocument   textDocumentIdentifierType `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+type didCloseTextDocumentParamsType struct {
+	TextDocument textDocumentIdentifierType `json:"textDocument"`
+}
+
+type codeActionParamsType struct {
+	TextDocument textDocumentIdentifierType `json:"textDocument"`
+	Range        rangeType                  `json:"range"`
+	Context      struct {
+		Diagnostics []diagnosticType `json:"diagnostics"`
+	} `json:"context"`
+}
+
+type completionParamsType struct {
+	TextDocument textDocumentIdentifierType `json:"textDocument"`
+	Position     positionType               `json:"position"`
+}
+
+type publishDiagnosticsParamsType struct {
+	URI         string           `json:"uri"`
+	Diagnostics []diagnosticType `json:"diagnostics"`
+}
+
+type logMessageParamsType struct {
+	Type    int    `json:"type"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,268 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package lsp implements a Language Server Protocol server, which provides the rule violations in the Arduino
+// metadata files as diagnostics while they are edited.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/spf13/pflag"
+)
+
+// diagnosticsDelay is how long the server waits for further changes to the documents before linting them, so that the
+// project isn't linted on every keystroke.
+var diagnosticsDelay = 300 * time.Millisecond
+
+// Server is a Language Server Protocol server, communicating with the client via JSON-RPC.
+// Messages are handled one at a time, in the order they are received.
+type Server struct {
+	reader             *bufio.Reader
+	writer             io.Writer
+	flags              *pflag.FlagSet      // Flags the configuration is initialized from for the project of each document.
+	documents          map[string]string   // Text of the documents open in the client, by URI.
+	propertyKeys       map[string][]string // Keys defined by the JSON schemas, by schema file name.
+	pendingDiagnostics map[string]bool     // URIs of the changed documents which were not linted yet.
+	diagnosticsTimer   <-chan time.Time    // Fires when the changed documents are due to be linted.
+	initialized        bool
+	shutdown           bool
+}
+
+// readResultType is the result of reading a message from the client.
+type readResultType struct {
+	content []byte
+	err     error
+}
+
+// NewServer returns a server communicating with the client via the given reader and writer (usually stdin and stdout),
+// which lints the projects according to the given flags.
+func NewServer(reader io.Reader, writer io.Writer, flags *pflag.FlagSet) *Server {
+	return &Server{
+		reader:             bufio.NewReader(reader),
+		writer:             writer,
+		flags:              flags,
+		documents:          make(map[string]string),
+		propertyKeys:       make(map[string][]string),
+		pendingDiagnostics: make(map[string]bool),
+	}
+}
+
+// Serve handles the messages from the client until it sends the exit notification.
+// An error is returned if the client exits without first requesting a shutdown, or if the connection is lost.
+func (server *Server) Serve() error {
+	// Messages are read concurrently, so that the changed documents can be linted once the client stops sending changes.
+	messages := make(chan readResultType, 1)
+	go func() {
+		for {
+			content, err := readMessage(server.reader)
+			messages <- readResultType{content: content, err: err}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		var message readResultType
+		select {
+		case message = <-messages:
+		case <-server.diagnosticsTimer:
+			server.publishPendingDiagnostics()
+			continue
+		}
+		content, err := message.content, message.err
+		if err != nil {
+			return fmt.Errorf("Error while reading message from client: %v", err)
+		}
+
+		var request requestMessageType
+		if err := json.Unmarshal(content, &request); err != nil {
+			if err := server.respond(json.RawMessage("null"), nil, &responseErrorType{Code: parseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if request.Method == "exit" {
+			if !server.shutdown {
+				return fmt.Errorf("Client exited without requesting a shutdown")
+			}
+			return nil
+		}
+
+		result, responseError := server.handle(request)
+		if request.isNotification() {
+			continue
+		}
+		if err := server.respond(request.ID, result, responseError); err != nil {
+			return err
+		}
+	}
+}
+
+// handle handles the request or notification and returns the result.
+func (server *Server) handle(request requestMessageType) (interface{}, *responseErrorType) {
+	if request.Method == "" {
+		// The server doesn't send requests, so it doesn't expect any responses.
+		return nil, &responseErrorType{Code: invalidRequest, Message: "Message has no method"}
+	}
+	if !server.initialized && request.Method != "initialize" {
+		return nil, &responseErrorType{Code: serverNotInitialized, Message: "Server was not initialized"}
+	}
+	if server.shutdown {
+		return nil, &responseErrorType{Code: invalidRequest, Message: "Server was shut down"}
+	}
+
+	switch request.Method {
+	case "initialize":
+		server.initialized = true
+		return initializeResultType{
+			Capabilities: serverCapabilitiesType{
+				TextDocumentSync:   textDocumentSyncOptionsType{OpenClose: true, Change: fullTextDocumentSync},
+				CodeActionProvider: codeActionOptionsType{CodeActionKinds: []string{quickFixCodeActionKind, fixAllCodeActionKind}},
+				CompletionProvider: completionOptionsType{TriggerCharacters: []string{"."}},
+			},
+			ServerInfo: serverInfoType{Name: "arduino-lint", Version: configuration.Version()},
+		}, nil
+	case "shutdown":
+		server.publishPendingDiagnostics()
+		server.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params didOpenTextDocumentParamsType
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, &responseErrorType{Code: invalidParams, Message: err.Error()}
+		}
+		server.documents[params.TextDocument.URI] = params.TextDocument.Text
+		server.publishDiagnostics(params.TextDocument.URI)
+		return nil, nil
+	case "textDocument/didChange":
+		var params didChangeTextDocumentParamsType
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, &responseErrorType{Code: invalidParams, Message: err.Error()}
+		}
+		if len(params.ContentChanges) > 0 {
+			// Full text document sync, so the last change has the current text.
+			server.documents[params.TextDocument.URI] = params.ContentChanges[len(params.ContentChanges)-1].Text
+		}
+		// Linting is deferred until the client stops sending changes.
+		server.pendingDiagnostics[params.TextDocument.URI] = true
+		server.diagnosticsTimer = time.After(diagnosticsDelay)
+		return nil, nil
+	case "textDocument/didClose":
+		var params didCloseTextDocumentParamsType
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, &responseErrorType{Code: invalidParams, Message: err.Error()}
+		}
+		delete(server.documents, params.TextDocument.URI)
+		delete(server.pendingDiagnostics, params.TextDocument.URI)
+		server.notify("textDocument/publishDiagnostics", publishDiagnosticsParamsType{URI: params.TextDocument.URI, Diagnostics: []diagnosticType{}})
+		return nil, nil
+	case "textDocument/codeAction":
+		var params codeActionParamsType
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, &responseErrorType{Code: invalidParams, Message: err.Error()}
+		}
+		return server.codeActions(params), nil
+	case "textDocument/completion":
+		var params completionParamsType
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, &responseErrorType{Code: invalidParams, Message: err.Error()}
+		}
+		return server.completion(params), nil
+	default:
+		if request.isNotification() {
+			return nil, nil // Unsupported notifications are ignored.
+		}
+		return nil, &responseErrorType{Code: methodNotFound, Message: fmt.Sprintf("Method %s is not supported", request.Method)}
+	}
+}
+
+// publishPendingDiagnostics publishes the diagnostics of the changed documents, linting each project only once.
+func (server *Server) publishPendingDiagnostics() {
+	uris := []string{}
+	for uri := range server.pendingDiagnostics {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+
+	published := make(map[string]bool)
+	for _, uri := range uris {
+		if published[uri] {
+			continue // Already published with the diagnostics of another document of the project.
+		}
+		for publishedURI := range server.publishDiagnostics(uri) {
+			published[publishedURI] = true
+		}
+	}
+
+	server.pendingDiagnostics = make(map[string]bool)
+	server.diagnosticsTimer = nil
+}
+
+// publishDiagnostics lints the project of the document and publishes the diagnostics of its open documents, which are
+// returned.
+func (server *Server) publishDiagnostics(uri string) map[string][]diagnosticType {
+	documentDiagnostics, err := server.lint(uri)
+	if err != nil {
+		server.notify("window/logMessage", logMessageParamsType{Type: errorMessageType, Message: err.Error()})
+		return nil
+	}
+
+	// Publish in a consistent order.
+	uris := []string{}
+	for documentURI := range documentDiagnostics {
+		uris = append(uris, documentURI)
+	}
+	sort.Strings(uris)
+	for _, documentURI := range uris {
+		server.notify("textDocument/publishDiagnostics", publishDiagnosticsParamsType{URI: documentURI, Diagnostics: documentDiagnostics[documentURI]})
+	}
+
+	return documentDiagnostics
+}
+
+// respond sends the response to a request.
+func (server *Server) respond(id json.RawMessage, result interface{}, responseError *responseErrorType) error {
+	response := responseMessageType{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error:   responseError,
+	}
+	if responseError == nil {
+		resultJSON, err := json.Marshal(result)
+		if err != nil {
+			panic(err)
+		}
+		response.Result = resultJSON
+	}
+
+	if err := writeMessage(server.writer, response); err != nil {
+		return fmt.Errorf("Error while writing message to client: %v", err)
+	}
+	return nil
+}
+
+// notify sends a notification to the client. Errors are ignored, since the next read from a lost connection fails.
+func (server *Server) notify(method string, params interface{}) {
+	writeMessage(server.writer, notificationMessageType{JSONRPC: "2.0", Method: method, Params: params})
+}
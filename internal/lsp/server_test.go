@@ -0,0 +1,351 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataPath *paths.Path
+
+func init() {
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	testDataPath = paths.New(workingDirectory, "testdata")
+}
+
+// receivedMessageType is the type of the messages the test client receives from the server.
+type receivedMessageType struct {
+	ID     json.RawMessage    `json:"id"`
+	Method string             `json:"method"`
+	Params json.RawMessage    `json:"params"`
+	Result json.RawMessage    `json:"result"`
+	Error  *responseErrorType `json:"error"`
+}
+
+// testClientType is a client for testing the server.
+type testClientType struct {
+	t          *testing.T
+	writer     io.Writer
+	reader     *bufio.Reader
+	serveError chan error
+}
+
+// startServer starts a server and returns a client connected to it.
+func startServer(t *testing.T) *testClientType {
+	flags := test.ConfigurationFlags()
+	flags.Set("offline", "true")
+	require.Nil(t, configuration.Initialize(flags, []string{testDataPath.String()}))
+
+	serverReader, clientWriter := io.Pipe()
+	clientReader, serverWriter := io.Pipe()
+	client := testClientType{
+		t:          t,
+		writer:     clientWriter,
+		reader:     bufio.NewReader(clientReader),
+		serveError: make(chan error, 1),
+	}
+	go func() {
+		client.serveError <- NewServer(serverReader, serverWriter, flags).Serve()
+	}()
+
+	return &client
+}
+
+// send sends a request to the server, or a notification if id is 0.
+func (client *testClientType) send(id int, method string, params interface{}) {
+	message := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if id != 0 {
+		message["id"] = id
+	}
+	require.Nil(client.t, writeMessage(client.writer, message))
+}
+
+// receive returns the next message from the server.
+func (client *testClientType) receive() receivedMessageType {
+	content, err := readMessage(client.reader)
+	require.Nil(client.t, err)
+	var message receivedMessageType
+	require.Nil(client.t, json.Unmarshal(content, &message))
+	return message
+}
+
+// initialize initializes the server.
+func (client *testClientType) initialize() {
+	client.send(1, "initialize", map[string]interface{}{"capabilities": map[string]interface{}{}})
+	response := client.receive()
+	require.Nil(client.t, response.Error)
+	client.send(0, "initialized", map[string]interface{}{})
+}
+
+// exit shuts down the server and returns the error Serve returned.
+func (client *testClientType) exit() error {
+	client.send(99, "shutdown", nil)
+	response := client.receive()
+	require.Nil(client.t, response.Error)
+	assert.Equal(client.t, "null", string(response.Result))
+	client.send(0, "exit", nil)
+	return <-client.serveError
+}
+
+// fileURI returns the URI of the file at the given path.
+func fileURI(filePath *paths.Path) string {
+	return "file://" + filePath.String()
+}
+
+func TestServe(t *testing.T) {
+	client := startServer(t)
+
+	client.send(1, "textDocument/completion", map[string]interface{}{})
+	response := client.receive()
+	require.NotNil(t, response.Error)
+	assert.Equal(t, serverNotInitialized, response.Error.Code, "Requests before initialization are rejected")
+
+	client.send(2, "initialize", map[string]interface{}{"capabilities": map[string]interface{}{}})
+	response = client.receive()
+	require.Nil(t, response.Error)
+	var initializeResult initializeResultType
+	require.Nil(t, json.Unmarshal(response.Result, &initializeResult))
+	assert.Equal(t, fullTextDocumentSync, initializeResult.Capabilities.TextDocumentSync.Change)
+	assert.Equal(t, "arduino-lint", initializeResult.ServerInfo.Name)
+
+	client.send(3, "foo/bar", nil)
+	response = client.receive()
+	require.NotNil(t, response.Error)
+	assert.Equal(t, methodNotFound, response.Error.Code)
+
+	assert.Nil(t, client.exit())
+}
+
+func TestServeExitWithoutShutdown(t *testing.T) {
+	client := startServer(t)
+	client.initialize()
+	client.send(0, "exit", nil)
+	assert.Error(t, <-client.serveError)
+}
+
+func TestDiagnostics(t *testing.T) {
+	client := startServer(t)
+	client.initialize()
+
+	uri := fileURI(testDataPath.Join("Foo", "library.properties"))
+	data, err := testDataPath.Join("Foo", "library.properties").ReadFile()
+	require.Nil(t, err)
+	text := string(data)
+
+	client.send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "properties", "version": 1, "text": text},
+	})
+	notification := client.receive()
+	assert.Equal(t, "textDocument/publishDiagnostics", notification.Method)
+	var diagnosticsParams publishDiagnosticsParamsType
+	require.Nil(t, json.Unmarshal(notification.Params, &diagnosticsParams))
+	assert.Equal(t, uri, diagnosticsParams.URI)
+	assert.Empty(t, diagnosticsParams.Diagnostics)
+
+	// The diagnostics are for the text in the client, not the file on disk.
+	client.send(0, "textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]interface{}{{"text": strings.Replace(text, "version=1.0.0", "version=v1.0.0", 1)}},
+	})
+	notification = client.receive()
+	require.Nil(t, json.Unmarshal(notification.Params, &diagnosticsParams))
+	codes := []string{}
+	for _, diagnostic := range diagnosticsParams.Diagnostics {
+		codes = append(codes, diagnostic.Code)
+		if diagnostic.Code == "LP020" {
+			assert.Equal(t, errorSeverity, diagnostic.Severity)
+			assert.Equal(t, rangeType{Start: positionType{Line: 1, Character: 0}, End: positionType{Line: 1, Character: 14}}, diagnostic.Range)
+		}
+	}
+	assert.Contains(t, codes, "LP020")
+
+	client.send(4, "textDocument/codeAction", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"range":        rangeType{Start: positionType{Line: 1, Character: 0}, End: positionType{Line: 1, Character: 0}},
+		"context":      map[string]interface{}{"diagnostics": diagnosticsParams.Diagnostics},
+	})
+	response := client.receive()
+	require.Nil(t, response.Error)
+	var codeActions []codeActionType
+	require.Nil(t, json.Unmarshal(response.Result, &codeActions))
+	require.Len(t, codeActions, 2)
+	assert.Equal(t, "Changed version field value v1.0.0 to 1.0.0", codeActions[0].Title)
+	assert.Equal(t, quickFixCodeActionKind, codeActions[0].Kind)
+	assert.Equal(t, "version=1.0.0", codeActions[0].Edit.Changes[uri][0].NewText)
+	assert.NotEmpty(t, codeActions[0].Diagnostics)
+	assert.Equal(t, fixAllCodeActionKind, codeActions[1].Kind)
+
+	client.send(0, "textDocument/didClose", map[string]interface{}{"textDocument": map[string]interface{}{"uri": uri}})
+	notification = client.receive()
+	require.Nil(t, json.Unmarshal(notification.Params, &diagnosticsParams))
+	assert.Empty(t, diagnosticsParams.Diagnostics, "Diagnostics are cleared when the document is closed")
+
+	assert.Nil(t, client.exit())
+}
+
+func TestDiagnosticsConfigurationFile(t *testing.T) {
+	client := startServer(t)
+	client.initialize()
+
+	// The configuration file of the project disables the rule.
+	uri := fileURI(testDataPath.Join("Configured", "library.properties"))
+	data, err := testDataPath.Join("Configured", "library.properties").ReadFile()
+	require.Nil(t, err)
+	client.send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "properties", "version": 1, "text": string(data)},
+	})
+	notification := client.receive()
+	var diagnosticsParams publishDiagnosticsParamsType
+	require.Nil(t, json.Unmarshal(notification.Params, &diagnosticsParams))
+	for _, diagnostic := range diagnosticsParams.Diagnostics {
+		assert.NotEqual(t, "LP020", diagnostic.Code)
+	}
+
+	// The configuration file of another project doesn't apply.
+	uri = fileURI(testDataPath.Join("Foo", "library.properties"))
+	client.send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "properties", "version": 1, "text": string(data)},
+	})
+	notification = client.receive()
+	require.Nil(t, json.Unmarshal(notification.Params, &diagnosticsParams))
+	codes := []string{}
+	for _, diagnostic := range diagnosticsParams.Diagnostics {
+		codes = append(codes, diagnostic.Code)
+	}
+	assert.Contains(t, codes, "LP020")
+
+	assert.Nil(t, client.exit())
+}
+
+func TestDiagnosticsDebounce(t *testing.T) {
+	client := startServer(t)
+	client.initialize()
+
+	uri := fileURI(testDataPath.Join("Foo", "library.properties"))
+	data, err := testDataPath.Join("Foo", "library.properties").ReadFile()
+	require.Nil(t, err)
+	text := string(data)
+
+	client.send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "properties", "version": 1, "text": text},
+	})
+	client.receive()
+
+	client.send(0, "textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]interface{}{{"text": strings.Replace(text, "version=1.0.0", "version=v1.0.0", 1)}},
+	})
+	client.send(0, "textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 3},
+		"contentChanges": []map[string]interface{}{{"text": text}},
+	})
+	notification := client.receive()
+	assert.Equal(t, "textDocument/publishDiagnostics", notification.Method)
+	var diagnosticsParams publishDiagnosticsParamsType
+	require.Nil(t, json.Unmarshal(notification.Params, &diagnosticsParams))
+	assert.Empty(t, diagnosticsParams.Diagnostics, "Changes in quick succession are linted once, with the last text")
+
+	client.send(2, "textDocument/completion", completionParamsType{
+		TextDocument: textDocumentIdentifierType{URI: uri},
+		Position:     positionType{Line: 0, Character: 0},
+	})
+	response := client.receive()
+	assert.Equal(t, "2", string(response.ID), "No further diagnostics are published")
+
+	assert.Nil(t, client.exit())
+}
+
+func TestCompletion(t *testing.T) {
+	client := startServer(t)
+	client.initialize()
+
+	uri := fileURI(testDataPath.Join("Platform", "boards.txt"))
+	client.send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "properties", "version": 1, "text": "uno.name=Arduino Uno\nuno.bu"},
+	})
+	client.receive()
+
+	client.send(2, "textDocument/completion", completionParamsType{
+		TextDocument: textDocumentIdentifierType{URI: uri},
+		Position:     positionType{Line: 1, Character: 6},
+	})
+	response := client.receive()
+	require.Nil(t, response.Error)
+	var completionList completionListType
+	require.Nil(t, json.Unmarshal(response.Result, &completionList))
+	labels := []string{}
+	for _, item := range completionList.Items {
+		labels = append(labels, item.Label)
+	}
+	assert.Contains(t, labels, "uno.build.board")
+	assert.Contains(t, labels, "menu")
+	assert.Equal(t, rangeType{Start: positionType{Line: 1, Character: 0}, End: positionType{Line: 1, Character: 6}}, completionList.Items[0].TextEdit.Range)
+
+	client.send(3, "textDocument/completion", completionParamsType{
+		TextDocument: textDocumentIdentifierType{URI: uri},
+		Position:     positionType{Line: 0, Character: 12},
+	})
+	response = client.receive()
+	require.Nil(t, json.Unmarshal(response.Result, &completionList))
+	assert.Empty(t, completionList.Items, "No completion of values")
+
+	assert.Nil(t, client.exit())
+}
+
+func Test_keyCompletion(t *testing.T) {
+	completion, ok := keyCompletion("version", "ver")
+	assert.True(t, ok)
+	assert.Equal(t, "version", completion)
+
+	completion, ok = keyCompletion("*.menu.*.*.upload.tool", "uno.menu.cpu.atmega328.up")
+	assert.True(t, ok)
+	assert.Equal(t, "uno.menu.cpu.atmega328.upload.tool", completion)
+
+	_, ok = keyCompletion("*.name", "un")
+	assert.False(t, ok, "Pattern segment not typed")
+
+	_, ok = keyCompletion("menu.*", "menu.")
+	assert.False(t, ok, "Nothing to complete after pattern segment")
+}
+
+func Test_documentProject(t *testing.T) {
+	lintedProject, ok := documentProject(paths.New("/foo/Library/library.properties"))
+	assert.True(t, ok)
+	assert.Equal(t, "Library", lintedProject.Path.Base())
+	assert.Equal(t, "library", lintedProject.ProjectType.String())
+
+	lintedProject, ok = documentProject(paths.New("/foo/package_foo_index.json"))
+	assert.True(t, ok)
+	assert.Equal(t, "package_foo_index.json", lintedProject.Path.Base())
+	assert.Equal(t, "package-index", lintedProject.ProjectType.String())
+
+	_, ok = documentProject(paths.New("/foo/Library/src/Library.h"))
+	assert.False(t, ok)
+}
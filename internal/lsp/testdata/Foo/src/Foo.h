@@ -0,0 +1,3 @@
+#ifndef FOO_H
+#define FOO_H
+#endif
@@ -0,0 +1,75 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+// Conversion between the positions in the documents used by the protocol and those used by Arduino Lint.
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// documentLines returns the lines of the document text, without line endings.
+func documentLines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for index := range lines {
+		lines[index] = strings.TrimSuffix(lines[index], "\r")
+	}
+
+	return lines
+}
+
+// lineRange returns the range of the given line of the document, from the given column. line and column are 1-based,
+// as in the rule violation locations. A line of 0 is the first line, and a column of 0 is the first non-whitespace
+// character of the line.
+func lineRange(lines []string, line int, column int) rangeType {
+	lineIndex := line - 1
+	if lineIndex < 0 {
+		lineIndex = 0
+	}
+	if lineIndex >= len(lines) {
+		lineIndex = len(lines) - 1
+	}
+	lineText := lines[lineIndex]
+
+	startOffset := len(lineText) - len(strings.TrimLeft(lineText, " \t"))
+	if column > 0 && column-1 <= len(lineText) {
+		startOffset = column - 1
+	}
+
+	return rangeType{
+		Start: positionType{Line: lineIndex, Character: utf16Length(lineText[:startOffset])},
+		End:   positionType{Line: lineIndex, Character: utf16Length(lineText)},
+	}
+}
+
+// utf16Length returns the length of the text in UTF-16 code units, which the protocol uses for character offsets.
+func utf16Length(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
+
+// byteOffset returns the byte offset in the line text of the given character offset in UTF-16 code units.
+func byteOffset(lineText string, character int) int {
+	units := 0
+	for offset, lineRune := range lineText {
+		if units >= character {
+			return offset
+		}
+		units += len(utf16.Encode([]rune{lineRune}))
+	}
+
+	return len(lineText)
+}
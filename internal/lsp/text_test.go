@@ -0,0 +1,40 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_lineRange(t *testing.T) {
+	lines := documentLines("foo=bar\r\n  baz=qux\n")
+	assert.Equal(t, []string{"foo=bar", "  baz=qux", ""}, lines)
+
+	assert.Equal(t, rangeType{Start: positionType{Line: 0, Character: 0}, End: positionType{Line: 0, Character: 7}}, lineRange(lines, 0, 0), "No line")
+	assert.Equal(t, rangeType{Start: positionType{Line: 1, Character: 2}, End: positionType{Line: 1, Character: 9}}, lineRange(lines, 2, 0), "Leading whitespace")
+	assert.Equal(t, rangeType{Start: positionType{Line: 1, Character: 6}, End: positionType{Line: 1, Character: 9}}, lineRange(lines, 2, 7), "Column")
+	assert.Equal(t, rangeType{Start: positionType{Line: 2, Character: 0}, End: positionType{Line: 2, Character: 0}}, lineRange(lines, 42, 0), "Line past the end")
+}
+
+func Test_byteOffset(t *testing.T) {
+	// "é" is two bytes in UTF-8 and one code unit in UTF-16. "😀" is four bytes in UTF-8 and two code units in UTF-16.
+	assert.Equal(t, 3, byteOffset("éa", 2))
+	assert.Equal(t, 5, byteOffset("😀a", 3))
+	assert.Equal(t, 5, byteOffset("😀a", 42))
+	assert.Equal(t, 3, utf16Length("😀a"))
+}
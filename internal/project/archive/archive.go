@@ -0,0 +1,204 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package archive provides functions for working with the release archives of Arduino projects, as distributed by
+// Library Manager and Boards Manager.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// validExtensions is the file extensions of the supported archive formats.
+var validExtensions = []string{".zip", ".tar.gz", ".tar.bz2"}
+
+// HasValidExtension returns whether the file at the given path has the extension of a supported archive format.
+func HasValidExtension(archivePath *paths.Path) bool {
+	for _, validExtension := range validExtensions {
+		if strings.HasSuffix(strings.ToLower(archivePath.Base()), validExtension) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// extractorType extracts the items of an archive.
+type extractorType struct {
+	destinationPath *paths.Path
+	rootName        string // Name of the archive's root folder.
+	rootIsFolder    bool
+}
+
+// Extract extracts the archive to the given folder and returns the path of the archive's root folder.
+// Release archives must contain a single root folder, and must not contain absolute paths or paths with `..` elements.
+// Symbolic links and other special files are not extracted.
+func Extract(archivePath *paths.Path, destinationPath *paths.Path) (*paths.Path, error) {
+	extractor := extractorType{destinationPath: destinationPath}
+
+	var err error
+	lowercaseBase := strings.ToLower(archivePath.Base())
+	switch {
+	case strings.HasSuffix(lowercaseBase, ".zip"):
+		err = extractor.extractZip(archivePath)
+	case strings.HasSuffix(lowercaseBase, ".tar.gz"), strings.HasSuffix(lowercaseBase, ".tar.bz2"):
+		err = extractor.extractTar(archivePath)
+	default:
+		err = fmt.Errorf("Unsupported archive format")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if extractor.rootName == "" {
+		return nil, fmt.Errorf("Archive is empty")
+	}
+	if !extractor.rootIsFolder {
+		return nil, fmt.Errorf("Archive root item %s is not a folder", extractor.rootName)
+	}
+
+	return destinationPath.Join(extractor.rootName), nil
+}
+
+// extractZip extracts the items of the zip archive.
+func (extractor *extractorType) extractZip(archivePath *paths.Path) error {
+	zipReader, err := zip.OpenReader(archivePath.String())
+	if err != nil {
+		return err
+	}
+	defer zipReader.Close()
+
+	for _, file := range zipReader.File {
+		err := extractor.extractItem(file.Name, file.Mode(), func() (io.ReadCloser, error) { return file.Open() })
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractTar extracts the items of the compressed tar archive.
+func (extractor *extractorType) extractTar(archivePath *paths.Path) error {
+	archiveFile, err := archivePath.Open()
+	if err != nil {
+		return err
+	}
+	defer archiveFile.Close()
+
+	var decompressedReader io.Reader
+	if strings.HasSuffix(strings.ToLower(archivePath.Base()), ".tar.bz2") {
+		decompressedReader = bzip2.NewReader(archiveFile)
+	} else {
+		gzipReader, err := gzip.NewReader(archiveFile)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		decompressedReader = gzipReader
+	}
+
+	tarReader := tar.NewReader(decompressedReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag == tar.TypeXGlobalHeader {
+			continue // Metadata added by `git archive`, not an item.
+		}
+
+		err = extractor.extractItem(header.Name, header.FileInfo().Mode(), func() (io.ReadCloser, error) { return ioutil.NopCloser(tarReader), nil })
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractItem validates the path of the archive item and extracts it.
+func (extractor *extractorType) extractItem(name string, mode os.FileMode, open func() (io.ReadCloser, error)) error {
+	slashName := strings.ReplaceAll(name, `\`, "/")
+	if path.IsAbs(slashName) || filepath.VolumeName(name) != "" {
+		return fmt.Errorf("Archive contains an absolute path: %s", name)
+	}
+	for _, element := range strings.Split(slashName, "/") {
+		if element == ".." {
+			return fmt.Errorf("Archive contains a path outside the root folder: %s", name)
+		}
+	}
+
+	itemPath := path.Clean(slashName)
+	if itemPath == "." {
+		return nil
+	}
+	rootName := strings.SplitN(itemPath, "/", 2)[0]
+	if extractor.rootName == "" {
+		extractor.rootName = rootName
+	} else if rootName != extractor.rootName {
+		return fmt.Errorf("Archive has more than one root item: %s and %s", extractor.rootName, rootName)
+	}
+	if itemPath != rootName || mode.IsDir() {
+		// Archives are not required to contain entries for folders, only for the files in them.
+		extractor.rootIsFolder = true
+	}
+
+	destinationItemPath := extractor.destinationPath.Join(filepath.FromSlash(itemPath))
+	switch {
+	case mode.IsDir():
+		return destinationItemPath.MkdirAll()
+	case mode.IsRegular():
+		if err := destinationItemPath.Parent().MkdirAll(); err != nil {
+			return err
+		}
+		return extractFile(destinationItemPath, mode, open)
+	default:
+		return nil
+	}
+}
+
+// extractFile writes the content of the archive item to the file at the given path.
+func extractFile(filePath *paths.Path, mode os.FileMode, open func() (io.ReadCloser, error)) error {
+	itemReader, err := open()
+	if err != nil {
+		return err
+	}
+	defer itemReader.Close()
+
+	file, err := os.OpenFile(filePath.String(), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm()|0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, itemReader); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,136 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// itemType is the type of the items of the test archives. Items with no content are folders.
+type itemType struct {
+	name    string
+	content string
+}
+
+var validItems = []itemType{
+	{name: "Foo/"},
+	{name: "Foo/library.properties", content: "name=Foo\n"},
+	{name: "Foo/src/Foo.h", content: "#include <Arduino.h>\n"},
+}
+
+// temporaryFolder returns a temporary folder, which is removed at the end of the test.
+func temporaryFolder(t *testing.T) *paths.Path {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-archive-test")
+	require.Nil(t, err)
+	t.Cleanup(func() { temporaryPath.RemoveAll() })
+	return temporaryPath
+}
+
+// writeZip writes a zip archive with the given items.
+func writeZip(t *testing.T, archivePath *paths.Path, items []itemType) {
+	archiveFile, err := archivePath.Create()
+	require.Nil(t, err)
+	defer archiveFile.Close()
+
+	zipWriter := zip.NewWriter(archiveFile)
+	for _, item := range items {
+		itemWriter, err := zipWriter.Create(item.name)
+		require.Nil(t, err)
+		_, err = itemWriter.Write([]byte(item.content))
+		require.Nil(t, err)
+	}
+	require.Nil(t, zipWriter.Close())
+}
+
+// writeTarGz writes a gzip compressed tar archive with the given items.
+func writeTarGz(t *testing.T, archivePath *paths.Path, items []itemType) {
+	archiveFile, err := archivePath.Create()
+	require.Nil(t, err)
+	defer archiveFile.Close()
+
+	gzipWriter := gzip.NewWriter(archiveFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+	require.Nil(t, tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeXGlobalHeader, Name: "pax_global_header", PAXRecords: map[string]string{"comment": "foo"}}))
+	for _, item := range items {
+		header := tar.Header{Typeflag: tar.TypeReg, Name: item.name, Mode: 0644, Size: int64(len(item.content))}
+		if item.content == "" {
+			header.Typeflag = tar.TypeDir
+			header.Mode = 0755
+		}
+		require.Nil(t, tarWriter.WriteHeader(&header))
+		_, err := tarWriter.Write([]byte(item.content))
+		require.Nil(t, err)
+	}
+	require.Nil(t, tarWriter.Close())
+	require.Nil(t, gzipWriter.Close())
+}
+
+func TestHasValidExtension(t *testing.T) {
+	assert.True(t, HasValidExtension(paths.New("/foo/Foo-1.0.0.zip")))
+	assert.True(t, HasValidExtension(paths.New("/foo/Foo-1.0.0.tar.gz")))
+	assert.True(t, HasValidExtension(paths.New("/foo/Foo-1.0.0.TAR.BZ2")))
+	assert.False(t, HasValidExtension(paths.New("/foo/Foo-1.0.0.gz")))
+	assert.False(t, HasValidExtension(paths.New("/foo/Foo-1.0.0")))
+}
+
+func TestExtract(t *testing.T) {
+	temporaryPath := temporaryFolder(t)
+	writeZip(t, temporaryPath.Join("Foo.zip"), validItems)
+	writeTarGz(t, temporaryPath.Join("Foo.tar.gz"), validItems)
+
+	for _, archiveName := range []string{"Foo.zip", "Foo.tar.gz"} {
+		destinationPath := temporaryPath.Join(archiveName + "-extracted")
+		require.Nil(t, destinationPath.Mkdir())
+		rootPath, err := Extract(temporaryPath.Join(archiveName), destinationPath)
+		require.Nil(t, err, archiveName)
+		assert.True(t, rootPath.EquivalentTo(destinationPath.Join("Foo")), archiveName)
+		content, err := rootPath.Join("src", "Foo.h").ReadFile()
+		require.Nil(t, err, archiveName)
+		assert.Equal(t, "#include <Arduino.h>\n", string(content), archiveName)
+	}
+}
+
+func TestExtractInvalid(t *testing.T) {
+	testTables := []struct {
+		testName string
+		items    []itemType
+	}{
+		{"Empty", []itemType{}},
+		{"Multiple roots", []itemType{{name: "Foo/Foo.h", content: "foo"}, {name: "README.md", content: "foo"}}},
+		{"Root file", []itemType{{name: "Foo.h", content: "foo"}}},
+		{"Absolute path", []itemType{{name: "/Foo/Foo.h", content: "foo"}}},
+		{"Parent path", []itemType{{name: "Foo/../../Foo.h", content: "foo"}}},
+	}
+
+	temporaryPath := temporaryFolder(t)
+	for _, testTable := range testTables {
+		archivePath := temporaryPath.Join(testTable.testName + ".zip")
+		writeZip(t, archivePath, testTable.items)
+		destinationPath := temporaryPath.Join(testTable.testName)
+		require.Nil(t, destinationPath.Mkdir())
+
+		_, err := Extract(archivePath, destinationPath)
+		assert.Error(t, err, testTable.testName)
+	}
+	assert.False(t, temporaryPath.Join("Foo.h").Exist(), "Nothing written outside the destination folder")
+}
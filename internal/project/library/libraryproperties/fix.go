@@ -0,0 +1,176 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraryproperties
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// misspelledFieldNames maps the correct names of optional fields to the patterns of their common misspellings.
+// The patterns are equivalent to those of the "misspelledOptionalProperties" definition of the JSON schema.
+var misspelledFieldNames = map[string]*regexp.Regexp{
+	"depends":       regexp.MustCompile(`(?i)^depends?$`),
+	"dot_a_linkage": regexp.MustCompile(`(?i)^dot[_-]?a[_-]?linkages?$`),
+	"includes":      regexp.MustCompile(`(?i)^includes?$`),
+	"precompiled":   regexp.MustCompile(`(?i)^pre[_-]?compiled?$`),
+	"ldflags":       regexp.MustCompile(`(?i)^ld[_-]?flags?$`),
+}
+
+// enumFieldValues is the allowed values of the fields which have an enumerated set of values.
+var enumFieldValues = map[string][]string{
+	"dot_a_linkage": {"true", "false"},
+	"precompiled":   {"true", "full", "false"},
+}
+
+// relaxedSemverRegexp is equivalent to the "relaxedSemver" pattern of the JSON schema.
+var relaxedSemverRegexp = regexp.MustCompile(`^(0|[1-9]\d*)(\.(0|[1-9]\d*))?(\.(0|[1-9]\d*))?(-((0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(\.(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(\+([0-9a-zA-Z-]+(\.[0-9a-zA-Z-]+)*))?$`)
+
+// versionNumbersRegexp matches the dot separated numbers at the start of a version.
+var versionNumbersRegexp = regexp.MustCompile(`^[0-9.]+`)
+
+// propertyLineRegexp matches a property line of a properties file, capturing the leading whitespace, key, separator, value, and
+// trailing whitespace.
+var propertyLineRegexp = regexp.MustCompile(`^(\s*)([^=#\s][^=]*?)(\s*=\s*)(.*?)(\s*)$`)
+
+// Fix corrects the mechanically fixable problems in the given library.properties data, which was loaded into
+// libraryProperties. It returns the fixed data, along with descriptions of the fixes that were made.
+// The order of the fields, formatting, and comments are preserved.
+func Fix(libraryProperties *properties.Map, data []byte) ([]byte, []string) {
+	fixes := []string{}
+	renamedKeys := map[string]bool{}
+	lines := strings.Split(string(data), "\n")
+	for index, line := range lines {
+		lineMatch := propertyLineRegexp.FindStringSubmatch(line)
+		if lineMatch == nil {
+			continue // Blank or comment line.
+		}
+		leadingSpace, key, separator, value, trailingSpace := lineMatch[1], lineMatch[2], lineMatch[3], lineMatch[4], lineMatch[5]
+
+		fixedKey := fixKey(libraryProperties, renamedKeys, key)
+		if fixedKey != key {
+			renamedKeys[fixedKey] = true
+			fixes = append(fixes, fmt.Sprintf("Renamed field %s to %s", key, fixedKey))
+		}
+
+		fixedValue := fixValue(fixedKey, value)
+		if fixedValue != value {
+			fixes = append(fixes, fmt.Sprintf("Changed %s field value %s to %s", fixedKey, value, fixedValue))
+		}
+
+		lines[index] = leadingSpace + fixedKey + separator + fixedValue + trailingSpace
+	}
+
+	return []byte(strings.Join(lines, "\n")), fixes
+}
+
+// fixKey returns the corrected name of the given field. renamedKeys is the set of names other fields were already renamed to.
+func fixKey(libraryProperties *properties.Map, renamedKeys map[string]bool, key string) string {
+	// A field can't be renamed if that would result in a duplicate field.
+	keyAvailable := func(keyQuery string) bool {
+		return !libraryProperties.ContainsKey(keyQuery) && !renamedKeys[keyQuery]
+	}
+
+	if key == "email" && keyAvailable("maintainer") {
+		return "maintainer"
+	}
+
+	for correctKey, misspelledRegexp := range misspelledFieldNames {
+		if key != correctKey && misspelledRegexp.MatchString(key) && keyAvailable(correctKey) {
+			return correctKey
+		}
+	}
+
+	return key
+}
+
+// fixValue returns the corrected value of the given field.
+func fixValue(key string, value string) string {
+	switch key {
+	case "architectures":
+		return fixArchitectures(value)
+	case "version":
+		return fixVersion(value)
+	}
+
+	for _, allowedValue := range enumFieldValues[key] {
+		if strings.EqualFold(value, allowedValue) {
+			return allowedValue
+		}
+	}
+
+	return value
+}
+
+// fixArchitectures corrects the case of the common architecture names in the architectures field value.
+func fixArchitectures(architectures string) string {
+	architecturesList := strings.Split(architectures, ",")
+
+	architecturePresent := func(architectureQuery string) bool {
+		for _, architecture := range architecturesList {
+			if strings.TrimSpace(architecture) == architectureQuery {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	fixedArchitecturesList := []string{}
+	for _, architecture := range architecturesList {
+		trimmedArchitecture := strings.TrimSpace(architecture)
+		for _, commonArchitecture := range CommonArchitectures {
+			// If the correctly cased name is already present, changing the case would result in a duplicate.
+			if trimmedArchitecture != commonArchitecture && strings.EqualFold(trimmedArchitecture, commonArchitecture) && !architecturePresent(commonArchitecture) {
+				architecture = strings.Replace(architecture, trimmedArchitecture, commonArchitecture, 1)
+				break
+			}
+		}
+		fixedArchitecturesList = append(fixedArchitecturesList, architecture)
+	}
+
+	return strings.Join(fixedArchitecturesList, ",")
+}
+
+// fixVersion normalizes the version field value to relaxed semver, if possible.
+func fixVersion(version string) string {
+	if relaxedSemverRegexp.MatchString(version) {
+		return version
+	}
+
+	// Remove the common "v" prefix and leading zeros from the version numbers.
+	normalizedVersion := strings.TrimPrefix(strings.TrimPrefix(version, "v"), "V")
+	numbers := versionNumbersRegexp.FindString(normalizedVersion)
+	normalizedNumbers := []string{}
+	for _, number := range strings.Split(numbers, ".") {
+		if strings.TrimLeft(number, "0") == "" && number != "" {
+			number = "0"
+		} else {
+			number = strings.TrimLeft(number, "0")
+		}
+		normalizedNumbers = append(normalizedNumbers, number)
+	}
+	normalizedVersion = strings.Join(normalizedNumbers, ".") + strings.TrimPrefix(normalizedVersion, numbers)
+
+	if !relaxedSemverRegexp.MatchString(normalizedVersion) {
+		return version // Not mechanically fixable.
+	}
+
+	return normalizedVersion
+}
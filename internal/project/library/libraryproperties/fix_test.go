@@ -0,0 +1,58 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraryproperties
+
+import (
+	"testing"
+
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFix(t *testing.T) {
+	testTables := []struct {
+		testName       string
+		data           string
+		fixedData      string
+		fixesAssertion assert.ValueAssertionFunc
+	}{
+		{"Nothing to fix", "name=Foo\nversion=1.0.0\n", "name=Foo\nversion=1.0.0\n", assert.Empty},
+		{"Misspelled field name", "name=Foo\nDepends=Bar\n", "name=Foo\ndepends=Bar\n", assert.NotEmpty},
+		{"Misspelled field name, correct field present", "depend=Bar\ndepends=Baz\n", "depend=Bar\ndepends=Baz\n", assert.Empty},
+		{"Multiple misspellings of field name", "include=Foo.h\nIncludes=Bar.h\n", "includes=Foo.h\nIncludes=Bar.h\n", assert.NotEmpty},
+		{"Email as maintainer alias", "# Comment\nemail = Jane Doe <jane@example.com>\n", "# Comment\nmaintainer = Jane Doe <jane@example.com>\n", assert.NotEmpty},
+		{"Email with maintainer", "maintainer=Jane Doe\nemail=jane@example.com\n", "maintainer=Jane Doe\nemail=jane@example.com\n", assert.Empty},
+		{"Architecture case", "architectures=AVR, Samd,nrf5\n", "architectures=avr, samd,nRF5\n", assert.NotEmpty},
+		{"Architecture case, correct architecture present", "architectures=AVR,avr\n", "architectures=AVR,avr\n", assert.Empty},
+		{"Version prefix", "version=v1.2.3\n", "version=1.2.3\n", assert.NotEmpty},
+		{"Version leading zeros", "version=01.02.00-beta\n", "version=1.2.0-beta\n", assert.NotEmpty},
+		{"Version not fixable", "version=foo\n", "version=foo\n", assert.Empty},
+		{"dot_a_linkage case", "dot_a_linkage=True\n", "dot_a_linkage=true\n", assert.NotEmpty},
+		{"Misspelled dot_a_linkage", "dot_a_linkages=TRUE\n", "dot_a_linkage=true\n", assert.NotEmpty},
+		{"precompiled case", "precompiled=Full\r\n", "precompiled=full\r\n", assert.NotEmpty},
+		{"precompiled not fixable", "precompiled=yes\n", "precompiled=yes\n", assert.Empty},
+	}
+
+	for _, testTable := range testTables {
+		libraryProperties, err := properties.LoadFromBytes([]byte(testTable.data))
+		require.Nil(t, err, testTable.testName)
+
+		fixedData, fixes := Fix(libraryProperties, []byte(testTable.data))
+		assert.Equal(t, testTable.fixedData, string(fixedData), testTable.testName)
+		testTable.fixesAssertion(t, fixes, testTable.testName)
+	}
+}
@@ -17,6 +17,8 @@
 package libraryproperties
 
 import (
+	"sync"
+
 	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
@@ -30,7 +32,30 @@ func Properties(libraryPath *paths.Path) (*properties.Map, error) {
 	return properties.SafeLoadFromPath(libraryPath.Join("library.properties"))
 }
 
+// CommonArchitectures is the list of architecture names in common use, with their correct case.
+var CommonArchitectures = []string{
+	"apollo3",
+	"arc32",
+	"avr",
+	"esp32",
+	"esp8266",
+	"i586",
+	"i686",
+	"k210",
+	"mbed",
+	"megaavr",
+	"mraa",
+	"nRF5",
+	"nrf52",
+	"pic32",
+	"sam",
+	"samd",
+	"wiced",
+	"win10",
+}
+
 var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var schemaObjectMutex sync.Mutex // Projects may be validated concurrently.
 
 // Validate validates library.properties data against the JSON schema and returns a map of the result for each compliance level.
 func Validate(libraryProperties *properties.Map) map[compliancelevel.Type]schema.ValidationResult {
@@ -41,11 +66,13 @@ func Validate(libraryProperties *properties.Map) map[compliancelevel.Type]schema
 
 	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
 
+	schemaObjectMutex.Lock()
 	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
 		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-library-properties-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-library-properties-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-library-properties-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
 	}
+	schemaObjectMutex.Unlock()
 
 	// Convert the library.properties data from the native properties.Map type to the interface type required by the schema
 	// validation package.
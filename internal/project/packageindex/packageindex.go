@@ -20,9 +20,14 @@ See: https://arduino.github.io/arduino-cli/latest/package_index_json-specificati
 package packageindex
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sync"
 
+	"github.com/arduino/arduino-lint/internal/rule/schema"
+	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
+	"github.com/arduino/arduino-lint/internal/rule/schema/schemadata"
 	"github.com/arduino/go-paths-helper"
 )
 
@@ -83,3 +88,48 @@ func Find(folderPath *paths.Path) (*paths.Path, error) {
 
 	return nil, nil
 }
+
+// Properties parses the package index from the given path and returns the data.
+func Properties(packageIndexPath *paths.Path) (map[string]interface{}, error) {
+	rawIndex, err := packageIndexPath.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+
+	var indexData map[string]interface{}
+	if err := json.Unmarshal(rawIndex, &indexData); err != nil {
+		return nil, err
+	}
+	if indexData == nil {
+		return nil, fmt.Errorf("Package index %s contains no data", packageIndexPath)
+	}
+
+	return indexData, nil
+}
+
+var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var schemaObjectMutex sync.Mutex // Projects may be validated concurrently.
+
+// Validate validates package index data against the JSON schema and returns a map of the result for each compliance level.
+func Validate(packageIndex map[string]interface{}) map[compliancelevel.Type]schema.ValidationResult {
+	referencedSchemaFilenames := []string{
+		"general-definitions-schema.json",
+		"arduino-package-index-definitions-schema.json",
+	}
+
+	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
+
+	schemaObjectMutex.Lock()
+	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
+		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-package-index-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
+		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-package-index-schema.json", referencedSchemaFilenames, schemadata.Asset)
+		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-package-index-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
+	}
+	schemaObjectMutex.Unlock()
+
+	validationResults[compliancelevel.Permissive] = schema.Validate(packageIndex, schemaObject[compliancelevel.Permissive])
+	validationResults[compliancelevel.Specification] = schema.Validate(packageIndex, schemaObject[compliancelevel.Specification])
+	validationResults[compliancelevel.Strict] = schema.Validate(packageIndex, schemaObject[compliancelevel.Strict])
+
+	return validationResults
+}
@@ -19,6 +19,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
 )
@@ -81,3 +82,33 @@ func TestFind(t *testing.T) {
 		}
 	}
 }
+
+func TestProperties(t *testing.T) {
+	packageIndex, err := Properties(testDataPath.Join("ValidPackageIndex", "package_foo_index.json"))
+	assert.Nil(t, err)
+	assert.Contains(t, packageIndex, "packages")
+
+	_, err = Properties(testDataPath.Join("HasPackageIndex", "package_foo_index.json"))
+	assert.NotNil(t, err, "Empty file")
+
+	_, err = Properties(testDataPath.Join("nonexistent", "package_foo_index.json"))
+	assert.NotNil(t, err, "Nonexistent file")
+}
+
+func TestValidate(t *testing.T) {
+	packageIndex, err := Properties(testDataPath.Join("ValidPackageIndex", "package_foo_index.json"))
+	if err != nil {
+		panic(err)
+	}
+	validationResult := Validate(packageIndex)
+
+	assert.Nil(t, validationResult[compliancelevel.Permissive].Result)
+	assert.Nil(t, validationResult[compliancelevel.Specification].Result)
+	assert.Nil(t, validationResult[compliancelevel.Strict].Result)
+
+	delete(packageIndex["packages"].([]interface{})[0].(map[string]interface{}), "maintainer") // Remove required property.
+	validationResult = Validate(packageIndex)
+	assert.NotNil(t, validationResult[compliancelevel.Permissive].Result)
+	assert.NotNil(t, validationResult[compliancelevel.Specification].Result)
+	assert.NotNil(t, validationResult[compliancelevel.Strict].Result)
+}
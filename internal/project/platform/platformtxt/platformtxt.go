@@ -21,6 +21,7 @@ package platformtxt
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
@@ -36,6 +37,7 @@ func Properties(platformPath *paths.Path) (*properties.Map, error) {
 }
 
 var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var schemaObjectMutex sync.Mutex // Projects may be validated concurrently.
 
 // Validate validates platform.txt data against the JSON schema and returns a map of the result for each compliance level.
 func Validate(platformTxt *properties.Map) map[compliancelevel.Type]schema.ValidationResult {
@@ -46,11 +48,13 @@ func Validate(platformTxt *properties.Map) map[compliancelevel.Type]schema.Valid
 
 	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
 
+	schemaObjectMutex.Lock()
 	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
 		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-platform-txt-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-platform-txt-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-platform-txt-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
 	}
+	schemaObjectMutex.Unlock()
 
 	/*
 		Convert the platform.txt data from the native properties.Map type to the interface type required by the schema
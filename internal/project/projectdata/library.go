@@ -17,116 +17,204 @@ package projectdata
 
 import (
 	"encoding/json"
-	"io/ioutil"
-	"net/http"
-	"os"
+	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/library/libraryproperties"
-	"github.com/arduino/arduino-lint/internal/result/feedback"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
+	"github.com/arduino/arduino-lint/internal/util/httpcache"
+	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/client9/misspell"
 	"github.com/sirupsen/logrus"
 )
 
-// InitializeForLibrary gathers the library rule data for the specified project.
-func InitializeForLibrary(project project.Type) {
+// initializeForLibrary gathers the library rule data for the specified project.
+func (projectData *Type) initializeForLibrary(project project.Type) {
 	var err error
 
-	libraryProperties, libraryPropertiesLoadError = libraryproperties.Properties(project.Path)
-	if libraryPropertiesLoadError != nil {
-		logrus.Errorf("Error loading library.properties from %s: %s", project.Path, libraryPropertiesLoadError)
-		libraryPropertiesSchemaValidationResult = nil
+	projectData.libraryProperties, projectData.libraryPropertiesLoadError = libraryproperties.Properties(project.Path)
+	if projectData.libraryPropertiesLoadError != nil {
+		logrus.Errorf("Error loading library.properties from %s: %s", project.Path, projectData.libraryPropertiesLoadError)
+		projectData.libraryPropertiesSchemaValidationResult = nil
 	} else {
-		libraryPropertiesSchemaValidationResult = libraryproperties.Validate(libraryProperties)
+		projectData.libraryPropertiesSchemaValidationResult = libraryproperties.Validate(projectData.libraryProperties)
 	}
 
-	loadedLibrary, err = libraries.Load(project.Path, libraries.User)
+	projectData.loadedLibrary, err = libraries.Load(project.Path, libraries.User)
 	if err != nil {
 		logrus.Errorf("Error loading library from %s: %s", project.Path, err)
-		loadedLibrary = nil
-		sourceHeaders = nil
+		projectData.loadedLibrary = nil
+		projectData.sourceHeaders = nil
 	} else {
-		sourceHeaders, err = loadedLibrary.SourceHeaders()
+		projectData.sourceHeaders, err = projectData.loadedLibrary.SourceHeaders()
 		if err != nil {
 			panic(err)
 		}
 	}
 
-	if libraryManagerIndex == nil { // Only download the Library Manager index once.
-		url := "http://downloads.arduino.cc/libraries/library_index.json"
-		httpResponse, err := http.Get(url)
-		if err != nil {
-			feedback.Errorf("Unable to download Library Manager index from %s: %s", err, url)
-			os.Exit(1)
-		}
-		defer httpResponse.Body.Close()
+	if err := LoadLibraryManagerIndex(); err != nil {
+		panic(err)
+	}
+	sharedLibraryDataMutex.Lock()
+	projectData.libraryManagerIndex = libraryManagerIndex
+	projectData.libraryManagerIndexLoadError = libraryManagerIndexLoadError
+	sharedLibraryDataMutex.Unlock()
+	projectData.misspelledWordsReplacer = sharedMisspelledWordsReplacer()
+}
+
+// The Library Manager index and misspelled words replacer are the same for all projects, so they are only loaded once per
+// run and shared between the concurrently linted projects.
+var (
+	sharedLibraryDataMutex       sync.Mutex
+	libraryManagerIndexSource    libraryManagerIndexSourceType // The source of the loaded index. Zero if none is loaded.
+	libraryManagerIndex          map[string]interface{}
+	libraryManagerIndexLoadError error
+	misspelledWordsReplacer      *misspell.Replacer
+)
+
+// libraryManagerIndexSourceType identifies where the Library Manager index was loaded from. In offline mode, an index URL
+// is loaded from the cache instead of the network, so the offline setting is part of the source.
+type libraryManagerIndexSourceType struct {
+	libraryIndex string
+	offline      bool
+}
+
+// LoadLibraryManagerIndex loads the Library Manager index from the configured source, if it was not already loaded.
+// The index is loaded on demand when a library project is initialized, but errors can only be handled by loading it in advance.
+// In offline mode, failure to load the index is not an error. The rules that require the index are skipped instead.
+func LoadLibraryManagerIndex() error {
+	sharedLibraryDataMutex.Lock()
+	defer sharedLibraryDataMutex.Unlock()
+
+	source := libraryManagerIndexSourceType{libraryIndex: configuration.LibraryIndex(), offline: configuration.Offline()}
+	if libraryManagerIndexSource == source { // Only load the Library Manager index once.
+		return nil
+	}
 
-		bytes, err := ioutil.ReadAll(httpResponse.Body)
+	libraryManagerIndex = nil
+	libraryManagerIndexLoadError = nil
+	var err error
+	if isURL(configuration.LibraryIndex()) && configuration.Offline() {
+		// The cached copy from a previous run is the best that can be done without network access.
+		libraryManagerIndex, err = parseLibraryManagerIndex(httpcache.Cached(configuration.CachePath(), configuration.LibraryIndex()))
 		if err != nil {
-			panic(err)
+			// The failure is not cached, so the index is loaded as soon as it is available.
+			libraryManagerIndex = nil
+			libraryManagerIndexLoadError = fmt.Errorf("Unable to download Library Manager index from %s in offline mode. Use the --library-index flag to provide a local copy", configuration.LibraryIndex())
+			libraryManagerIndexSource = libraryManagerIndexSourceType{}
+			return nil
 		}
-
-		err = json.Unmarshal(bytes, &libraryManagerIndex)
+	} else {
+		libraryManagerIndex, err = loadLibraryManagerIndex(configuration.LibraryIndex())
 		if err != nil {
-			panic(err)
+			libraryManagerIndexSource = libraryManagerIndexSourceType{}
+			return fmt.Errorf("Unable to load Library Manager index from %s: %s", configuration.LibraryIndex(), err)
 		}
 	}
+	libraryManagerIndexSource = source
+
+	return nil
+}
+
+// ResetSharedData discards the data shared between the projects (e.g., the Library Manager index), so that it is loaded
+// again by the next run. Callers that lint more than once in a process must call it at the start of each run, since the
+// data may have changed in the meantime.
+func ResetSharedData() {
+	sharedLibraryDataMutex.Lock()
+	libraryManagerIndexSource = libraryManagerIndexSourceType{}
+	libraryManagerIndex = nil
+	libraryManagerIndexLoadError = nil
+	sharedLibraryDataMutex.Unlock()
+
+	sharedPlatformDataMutex.Lock()
+	platformPackageIndexPath = ""
+	sharedPlatformPackageIndex = nil
+	sharedPlatformDataMutex.Unlock()
+}
+
+// sharedMisspelledWordsReplacer returns the misspelled words replacer, compiling it if this was not already done.
+func sharedMisspelledWordsReplacer() *misspell.Replacer {
+	sharedLibraryDataMutex.Lock()
+	defer sharedLibraryDataMutex.Unlock()
 
 	if misspelledWordsReplacer == nil { // The replacer only needs to be compiled once per run.
 		misspelledWordsReplacer = misspell.New()
 		misspelledWordsReplacer.Compile()
 	}
-}
 
-var libraryPropertiesLoadError error
+	return misspelledWordsReplacer
+}
 
 // LibraryPropertiesLoadError returns the error output from loading the library.properties metadata file.
-func LibraryPropertiesLoadError() error {
-	return libraryPropertiesLoadError
+func (projectData *Type) LibraryPropertiesLoadError() error {
+	return projectData.libraryPropertiesLoadError
 }
 
-var libraryProperties *properties.Map
-
 // LibraryProperties returns the data from the library.properties metadata file.
-func LibraryProperties() *properties.Map {
-	return libraryProperties
+func (projectData *Type) LibraryProperties() *properties.Map {
+	return projectData.libraryProperties
 }
 
-var libraryPropertiesSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // LibraryPropertiesSchemaValidationResult returns the result of validating library.properties against the JSON schema.
-func LibraryPropertiesSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return libraryPropertiesSchemaValidationResult
+func (projectData *Type) LibraryPropertiesSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.libraryPropertiesSchemaValidationResult
 }
 
-var loadedLibrary *libraries.Library
-
 // LoadedLibrary returns the library object generated by Arduino CLI.
-func LoadedLibrary() *libraries.Library {
-	return loadedLibrary
+func (projectData *Type) LoadedLibrary() *libraries.Library {
+	return projectData.loadedLibrary
 }
 
-var sourceHeaders []string
-
 // SourceHeaders returns the list of library source header filenames discovered by Arduino CLI.
-func SourceHeaders() []string {
-	return sourceHeaders
+func (projectData *Type) SourceHeaders() []string {
+	return projectData.sourceHeaders
 }
 
-var libraryManagerIndex map[string]interface{}
+// loadLibraryManagerIndex loads the Library Manager index from the given path or URL.
+func loadLibraryManagerIndex(source string) (map[string]interface{}, error) {
+	if isURL(source) {
+		return parseLibraryManagerIndex(httpcache.Get(configuration.CachePath(), source))
+	}
+
+	return parseLibraryManagerIndex(paths.New(source).ReadFile())
+}
+
+// parseLibraryManagerIndex parses the given Library Manager index data.
+func parseLibraryManagerIndex(libraryManagerIndexData []byte, err error) (map[string]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var libraryManagerIndex map[string]interface{}
+	if err := json.Unmarshal(libraryManagerIndexData, &libraryManagerIndex); err != nil {
+		return nil, err
+	}
+
+	return libraryManagerIndex, nil
+}
+
+// isURL returns whether the given location is a URL rather than a local path.
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
 
 // LibraryManagerIndex returns the Library Manager index data.
-func LibraryManagerIndex() map[string]interface{} {
-	return libraryManagerIndex
+func (projectData *Type) LibraryManagerIndex() map[string]interface{} {
+	return projectData.libraryManagerIndex
 }
 
-var misspelledWordsReplacer *misspell.Replacer
+// LibraryManagerIndexLoadError returns the error from loading the Library Manager index, which is only possible in offline mode.
+func (projectData *Type) LibraryManagerIndexLoadError() error {
+	return projectData.libraryManagerIndexLoadError
+}
 
 // MisspelledWordsReplacer returns the misspelled words replacer used for spell check.
-func MisspelledWordsReplacer() *misspell.Replacer {
-	return misspelledWordsReplacer
+func (projectData *Type) MisspelledWordsReplacer() *misspell.Replacer {
+	return projectData.misspelledWordsReplacer
 }
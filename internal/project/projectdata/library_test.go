@@ -0,0 +1,78 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package projectdata
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLibraryManagerIndex(t *testing.T) {
+	libraryManagerIndexData := `{"libraries": []}`
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.Header().Set("ETag", fmt.Sprintf(`"%d"`, len(libraryManagerIndexData)))
+		responseWriter.Write([]byte(libraryManagerIndexData))
+	}))
+	defer server.Close()
+
+	cachePath, err := paths.MkTempDir("", "arduino-lint-projectdata-test")
+	require.Nil(t, err)
+	defer cachePath.RemoveAll()
+
+	workingDirectory, err := paths.Getwd()
+	require.Nil(t, err)
+	configure := func(offline bool) {
+		flags := test.ConfigurationFlags()
+		flags.Set("library-index", server.URL)
+		flags.Set("no-cache", "false")
+		flags.Set("cache-dir", cachePath.String())
+		flags.Set("offline", fmt.Sprint(offline))
+		require.Nil(t, configuration.Initialize(flags, []string{workingDirectory.String()}))
+	}
+	defer configuration.Initialize(test.ConfigurationFlags(), []string{workingDirectory.String()})
+	defer ResetSharedData()
+
+	ResetSharedData()
+	configure(true)
+	require.Nil(t, LoadLibraryManagerIndex())
+	assert.Nil(t, libraryManagerIndex)
+	assert.NotNil(t, libraryManagerIndexLoadError, "No cached copy in offline mode")
+
+	configure(false)
+	require.Nil(t, LoadLibraryManagerIndex())
+	assert.Nil(t, libraryManagerIndexLoadError, "Offline load failure does not apply to online mode")
+	assert.Equal(t, map[string]interface{}{"libraries": []interface{}{}}, libraryManagerIndex)
+
+	libraryManagerIndexData = `{"libraries": [{"name": "Foo"}]}`
+	require.Nil(t, LoadLibraryManagerIndex())
+	assert.Len(t, libraryManagerIndex["libraries"], 0, "Index is only loaded once per run")
+
+	ResetSharedData()
+	require.Nil(t, LoadLibraryManagerIndex())
+	assert.Len(t, libraryManagerIndex["libraries"], 1, "Index is loaded again after reset")
+
+	configure(true)
+	require.Nil(t, LoadLibraryManagerIndex())
+	assert.Nil(t, libraryManagerIndexLoadError, "Offline mode uses cached copy")
+}
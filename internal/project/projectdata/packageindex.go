@@ -16,26 +16,140 @@
 package projectdata
 
 import (
+	"fmt"
+
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	projectpackageindex "github.com/arduino/arduino-lint/internal/project/packageindex"
+	"github.com/arduino/arduino-lint/internal/rule/schema"
+	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
 )
 
-// InitializeForPackageIndex gathers the package index rule data for the specified project.
-func InitializeForPackageIndex() {
-	if ProjectPath() != nil {
-		packageIndex, packageIndexLoadError = packageindex.LoadIndex(ProjectPath())
-	}
+// PackageIndexData is the type for an item of the package index data (e.g., a package or platform).
+type PackageIndexData struct {
+	ID          string                 // Human readable identifier for the item.
+	JSONPointer string                 // JSON pointer to the item in the package index.
+	Object      map[string]interface{} // The item's data.
 }
 
-var packageIndex *packageindex.Index
+// initializeForPackageIndex gathers the package index rule data for the specified project.
+func (projectData *Type) initializeForPackageIndex() {
+	if projectData.ProjectPath() != nil {
+		projectData.packageIndex, projectData.packageIndexLoadError = packageindex.LoadIndex(projectData.ProjectPath())
 
-// PackageIndex returns the packageindex.Index object generated by Arduino CLI.
-func PackageIndex() *packageindex.Index {
-	return packageIndex
+		projectData.packageIndexProperties, projectData.packageIndexPropertiesLoadError = projectpackageindex.Properties(projectData.ProjectPath())
+		if projectData.packageIndexPropertiesLoadError == nil {
+			projectData.packageIndexSchemaValidationResult = projectpackageindex.Validate(projectData.packageIndexProperties)
+
+			projectData.packageIndexPackages, projectData.packageIndexPlatforms, projectData.packageIndexTools = getPackageIndexData(projectData.packageIndexProperties)
+		}
+	}
 }
 
-var packageIndexLoadError error
+// PackageIndex returns the packageindex.Index object generated by Arduino CLI.
+func (projectData *Type) PackageIndex() *packageindex.Index {
+	return projectData.packageIndex
+}
 
 // PackageIndexLoadError returns the error return of packageindex.LoadIndex().
-func PackageIndexLoadError() error {
-	return packageIndexLoadError
+func (projectData *Type) PackageIndexLoadError() error {
+	return projectData.packageIndexLoadError
+}
+
+// PackageIndexProperties returns the data from the package index file, as unmarshalled from the JSON.
+func (projectData *Type) PackageIndexProperties() map[string]interface{} {
+	return projectData.packageIndexProperties
+}
+
+// PackageIndexPropertiesLoadError returns the error output from loading the package index file.
+func (projectData *Type) PackageIndexPropertiesLoadError() error {
+	return projectData.packageIndexPropertiesLoadError
+}
+
+// PackageIndexSchemaValidationResult returns the result of validating the package index against the JSON schema.
+func (projectData *Type) PackageIndexSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.packageIndexSchemaValidationResult
+}
+
+// PackageIndexPackages returns the list of package data items from the package index.
+func (projectData *Type) PackageIndexPackages() []PackageIndexData {
+	return projectData.packageIndexPackages
+}
+
+// PackageIndexPlatforms returns the list of platform data items from the package index.
+func (projectData *Type) PackageIndexPlatforms() []PackageIndexData {
+	return projectData.packageIndexPlatforms
+}
+
+// PackageIndexTools returns the list of tool data items from the package index.
+func (projectData *Type) PackageIndexTools() []PackageIndexData {
+	return projectData.packageIndexTools
+}
+
+// getPackageIndexData returns the package, platform, and tool data items from the given package index data.
+// Items of an unexpected type are skipped, since those problems are reported by the schema validation.
+func getPackageIndexData(packageIndexProperties map[string]interface{}) (packages []PackageIndexData, platforms []PackageIndexData, tools []PackageIndexData) {
+	packages = []PackageIndexData{}
+	platforms = []PackageIndexData{}
+	tools = []PackageIndexData{}
+
+	packagesArray, ok := packageIndexProperties["packages"].([]interface{})
+	if !ok {
+		return packages, platforms, tools
+	}
+
+	for packageIndex, packageInterface := range packagesArray {
+		packageObject, ok := packageInterface.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		packageJSONPointer := fmt.Sprintf("/packages/%d", packageIndex)
+		packageName, ok := packageObject["name"].(string)
+		if !ok || packageName == "" {
+			packageName = packageJSONPointer
+		}
+		packages = append(packages, PackageIndexData{
+			ID:          packageName,
+			JSONPointer: packageJSONPointer,
+			Object:      packageObject,
+		})
+
+		platforms = append(platforms, getPackageIndexReleaseData(packageObject, packageName, packageJSONPointer, "platforms", "architecture")...)
+		tools = append(tools, getPackageIndexReleaseData(packageObject, packageName, packageJSONPointer, "tools", "name")...)
+	}
+
+	return packages, platforms, tools
+}
+
+// getPackageIndexReleaseData returns the data items from the given array of platform or tool releases of the package.
+// The item ID is composed from the package name and the release's name property and version.
+func getPackageIndexReleaseData(packageObject map[string]interface{}, packageName string, packageJSONPointer string, releasesKey string, nameKey string) []PackageIndexData {
+	releases := []PackageIndexData{}
+
+	releasesArray, ok := packageObject[releasesKey].([]interface{})
+	if !ok {
+		return releases
+	}
+
+	for releaseIndex, releaseInterface := range releasesArray {
+		releaseObject, ok := releaseInterface.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		releaseJSONPointer := fmt.Sprintf("%s/%s/%d", packageJSONPointer, releasesKey, releaseIndex)
+		name, _ := releaseObject[nameKey].(string)
+		version, _ := releaseObject["version"].(string)
+		releaseID := releaseJSONPointer
+		if name != "" && version != "" {
+			releaseID = fmt.Sprintf("%s:%s@%s", packageName, name, version)
+		}
+		releases = append(releases, PackageIndexData{
+			ID:          releaseID,
+			JSONPointer: releaseJSONPointer,
+			Object:      releaseObject,
+		})
+	}
+
+	return releases
 }
@@ -20,6 +20,7 @@ import (
 
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
 )
@@ -53,11 +54,44 @@ func TestInitializeForPackageIndex(t *testing.T) {
 			ProjectType:      projecttype.PackageIndex,
 			SuperprojectType: projecttype.PackageIndex,
 		}
-		Initialize(testProject)
+		projectData := Initialize(testProject)
 
-		testTable.packageIndexLoadErrorAssertion(t, PackageIndexLoadError(), testTable.testName)
-		if PackageIndexLoadError() == nil {
-			testTable.packageIndexAssertion(t, PackageIndex(), testTable.testName)
+		testTable.packageIndexLoadErrorAssertion(t, projectData.PackageIndexLoadError(), testTable.testName)
+		if projectData.PackageIndexLoadError() == nil {
+			testTable.packageIndexAssertion(t, projectData.PackageIndex(), testTable.testName)
 		}
 	}
 }
+
+func TestInitializeForPackageIndexData(t *testing.T) {
+	testProject := project.Type{
+		Path:             packageIndexTestDataPath.Join("valid-package-index", "package_foo_index.json"),
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.PackageIndex,
+	}
+	projectData := Initialize(testProject)
+
+	assert.Nil(t, projectData.PackageIndexPropertiesLoadError())
+	assert.Nil(t, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification].Result)
+
+	assert.Equal(t, []string{"myboard"}, packageIndexDataIDs(projectData.PackageIndexPackages()))
+	assert.Equal(t, "/packages/0", projectData.PackageIndexPackages()[0].JSONPointer)
+	assert.Equal(t, []string{"myboard:avr@1.0.0", "myboard:avr@1.0.1"}, packageIndexDataIDs(projectData.PackageIndexPlatforms()))
+	assert.Equal(t, "/packages/0/platforms/1", projectData.PackageIndexPlatforms()[1].JSONPointer)
+	assert.Empty(t, projectData.PackageIndexTools())
+
+	testProject.Path = packageIndexTestDataPath.Join("invalid-package-index", "package_foo_index.json")
+	projectData = Initialize(testProject)
+
+	assert.NotNil(t, projectData.PackageIndexPropertiesLoadError(), "Root is not an object")
+}
+
+// packageIndexDataIDs returns the IDs of the given package index data items.
+func packageIndexDataIDs(items []PackageIndexData) []string {
+	iDs := []string{}
+	for _, item := range items {
+		iDs = append(iDs, item.ID)
+	}
+
+	return iDs
+}
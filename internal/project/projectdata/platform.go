@@ -16,6 +16,10 @@
 package projectdata
 
 import (
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/platform/boardstxt"
 	"github.com/arduino/arduino-lint/internal/project/platform/platformtxt"
@@ -26,154 +30,169 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// InitializeForPlatform gathers the platform rule data for the specified project.
-func InitializeForPlatform(project project.Type) {
-	boardsTxt, boardsTxtLoadError = boardstxt.Properties(ProjectPath())
-	if boardsTxtLoadError != nil {
-		logrus.Errorf("Error loading boards.txt from %s: %s", project.Path, boardsTxtLoadError)
-		boardsTxtSchemaValidationResult = nil
+// initializeForPlatform gathers the platform rule data for the specified project.
+func (projectData *Type) initializeForPlatform(project project.Type) {
+	projectData.boardsTxt, projectData.boardsTxtLoadError = boardstxt.Properties(projectData.ProjectPath())
+	if projectData.boardsTxtLoadError != nil {
+		logrus.Errorf("Error loading boards.txt from %s: %s", project.Path, projectData.boardsTxtLoadError)
+		projectData.boardsTxtSchemaValidationResult = nil
 	} else {
-		boardsTxtSchemaValidationResult = boardstxt.Validate(boardsTxt)
+		projectData.boardsTxtSchemaValidationResult = boardstxt.Validate(projectData.boardsTxt)
 
-		boardsTxtMenuIds = boardstxt.MenuIDs(boardsTxt)
-		boardsTxtBoardIds = boardstxt.BoardIDs(boardsTxt)
-		boardsTxtVisibleBoardIds = boardstxt.VisibleBoardIDs(boardsTxt)
+		projectData.boardsTxtMenuIds = boardstxt.MenuIDs(projectData.boardsTxt)
+		projectData.boardsTxtBoardIds = boardstxt.BoardIDs(projectData.boardsTxt)
+		projectData.boardsTxtVisibleBoardIds = boardstxt.VisibleBoardIDs(projectData.boardsTxt)
 	}
 
-	programmersTxtExists = ProjectPath().Join("programmers.txt").Exist()
+	projectData.programmersTxtExists = projectData.ProjectPath().Join("programmers.txt").Exist()
 
-	programmersTxt, programmersTxtLoadError = programmerstxt.Properties(ProjectPath())
-	if programmersTxtLoadError != nil {
-		logrus.Tracef("Error loading programmers.txt from %s: %s", project.Path, programmersTxtLoadError)
-		programmersTxtSchemaValidationResult = nil
+	projectData.programmersTxt, projectData.programmersTxtLoadError = programmerstxt.Properties(projectData.ProjectPath())
+	if projectData.programmersTxtLoadError != nil {
+		logrus.Tracef("Error loading programmers.txt from %s: %s", project.Path, projectData.programmersTxtLoadError)
+		projectData.programmersTxtSchemaValidationResult = nil
 	} else {
-		programmersTxtSchemaValidationResult = programmerstxt.Validate(programmersTxt)
+		projectData.programmersTxtSchemaValidationResult = programmerstxt.Validate(projectData.programmersTxt)
 
-		programmersTxtProgrammerIds = programmerstxt.ProgrammerIDs(programmersTxt)
+		projectData.programmersTxtProgrammerIds = programmerstxt.ProgrammerIDs(projectData.programmersTxt)
 	}
 
-	platformTxtExists = ProjectPath().Join("platform.txt").Exist()
+	projectData.platformTxtExists = projectData.ProjectPath().Join("platform.txt").Exist()
 
-	platformTxt, platformTxtLoadError = platformtxt.Properties(ProjectPath())
-	if platformTxtLoadError != nil {
-		logrus.Tracef("Error loading platform.txt from %s: %s", project.Path, platformTxtLoadError)
-		platformTxtSchemaValidationResult = nil
-		platformTxtToolNames = nil
+	projectData.platformTxt, projectData.platformTxtLoadError = platformtxt.Properties(projectData.ProjectPath())
+	if projectData.platformTxtLoadError != nil {
+		logrus.Tracef("Error loading platform.txt from %s: %s", project.Path, projectData.platformTxtLoadError)
+		projectData.platformTxtSchemaValidationResult = nil
+		projectData.platformTxtToolNames = nil
 	} else {
-		platformTxtSchemaValidationResult = platformtxt.Validate(platformTxt)
+		projectData.platformTxtSchemaValidationResult = platformtxt.Validate(projectData.platformTxt)
+
+		projectData.platformTxtToolNames = platformtxt.ToolNames(projectData.platformTxt)
+	}
 
-		platformTxtToolNames = platformtxt.ToolNames(platformTxt)
+	if configuration.PackageIndexPath() != nil {
+		projectData.platformPackageIndex, projectData.platformPackageIndexLoadError = loadPlatformPackageIndex()
+		if projectData.platformPackageIndexLoadError != nil {
+			logrus.Errorf("Error loading package index from %s: %s", configuration.PackageIndexPath(), projectData.platformPackageIndexLoadError)
+		}
 	}
 }
 
-var boardsTxt *properties.Map
+// The package index specified by the --package-index flag is the same for all platform projects, so it is only loaded
+// once per run and shared between the concurrently linted projects.
+var (
+	sharedPlatformDataMutex    sync.Mutex
+	platformPackageIndexPath   string
+	sharedPlatformPackageIndex *packageindex.Index
+)
+
+// loadPlatformPackageIndex returns the package index specified by the --package-index flag, loading it if this was not
+// already done. Failures are not cached, so that a corrected file is loaded by the next attempt.
+func loadPlatformPackageIndex() (*packageindex.Index, error) {
+	sharedPlatformDataMutex.Lock()
+	defer sharedPlatformDataMutex.Unlock()
 
-// BoardsTxt returns the data from the boards.txt configuration file.
-func BoardsTxt() *properties.Map {
-	return boardsTxt
+	if sharedPlatformPackageIndex != nil && platformPackageIndexPath == configuration.PackageIndexPath().String() {
+		return sharedPlatformPackageIndex, nil
+	}
+
+	index, err := packageindex.LoadIndex(configuration.PackageIndexPath())
+	if err != nil {
+		return nil, err
+	}
+	sharedPlatformPackageIndex = index
+	platformPackageIndexPath = configuration.PackageIndexPath().String()
+
+	return sharedPlatformPackageIndex, nil
 }
 
-var boardsTxtLoadError error
+// BoardsTxt returns the data from the boards.txt configuration file.
+func (projectData *Type) BoardsTxt() *properties.Map {
+	return projectData.boardsTxt
+}
 
 // BoardsTxtLoadError returns the error output from loading the boards.txt configuration file.
-func BoardsTxtLoadError() error {
-	return boardsTxtLoadError
+func (projectData *Type) BoardsTxtLoadError() error {
+	return projectData.boardsTxtLoadError
 }
 
-var boardsTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // BoardsTxtSchemaValidationResult returns the result of validating boards.txt against the JSON schema.
-func BoardsTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return boardsTxtSchemaValidationResult
+func (projectData *Type) BoardsTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.boardsTxtSchemaValidationResult
 }
 
-var boardsTxtMenuIds []string
-
 // BoardsTxtMenuIds returns the list of menu IDs present in the platform's boards.txt.
-func BoardsTxtMenuIds() []string {
-	return boardsTxtMenuIds
+func (projectData *Type) BoardsTxtMenuIds() []string {
+	return projectData.boardsTxtMenuIds
 }
 
-var boardsTxtBoardIds []string
-
 // BoardsTxtBoardIds returns the list of board IDs present in the platform's boards.txt.
-func BoardsTxtBoardIds() []string {
-	return boardsTxtBoardIds
+func (projectData *Type) BoardsTxtBoardIds() []string {
+	return projectData.boardsTxtBoardIds
 }
 
-var boardsTxtVisibleBoardIds []string
-
 // BoardsTxtVisibleBoardIds returns the list of IDs for visible boards present in the platform's boards.txt.
-func BoardsTxtVisibleBoardIds() []string {
-	return boardsTxtVisibleBoardIds
+func (projectData *Type) BoardsTxtVisibleBoardIds() []string {
+	return projectData.boardsTxtVisibleBoardIds
 }
 
-var programmersTxtExists bool
-
 // ProgrammersTxtExists returns whether the platform contains a programmer.txt file.
-func ProgrammersTxtExists() bool {
-	return programmersTxtExists
+func (projectData *Type) ProgrammersTxtExists() bool {
+	return projectData.programmersTxtExists
 }
 
-var programmersTxt *properties.Map
-
 // ProgrammersTxt returns the data from the programmers.txt configuration file.
-func ProgrammersTxt() *properties.Map {
-	return programmersTxt
+func (projectData *Type) ProgrammersTxt() *properties.Map {
+	return projectData.programmersTxt
 }
 
-var programmersTxtLoadError error
-
 // ProgrammersTxtLoadError returns the error output from loading the programmers.txt configuration file.
-func ProgrammersTxtLoadError() error {
-	return programmersTxtLoadError
+func (projectData *Type) ProgrammersTxtLoadError() error {
+	return projectData.programmersTxtLoadError
 }
 
-var programmersTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // ProgrammersTxtSchemaValidationResult returns the result of validating programmers.txt against the JSON schema.
-func ProgrammersTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return programmersTxtSchemaValidationResult
+func (projectData *Type) ProgrammersTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.programmersTxtSchemaValidationResult
 }
 
-var programmersTxtProgrammerIds []string
-
 // ProgrammersTxtProgrammerIds returns the list of board IDs present in the platform's programmers.txt.
-func ProgrammersTxtProgrammerIds() []string {
-	return programmersTxtProgrammerIds
+func (projectData *Type) ProgrammersTxtProgrammerIds() []string {
+	return projectData.programmersTxtProgrammerIds
 }
 
-var platformTxtExists bool
-
 // PlatformTxtExists returns whether the platform contains a programmer.txt file.
-func PlatformTxtExists() bool {
-	return platformTxtExists
+func (projectData *Type) PlatformTxtExists() bool {
+	return projectData.platformTxtExists
 }
 
-var platformTxt *properties.Map
-
 // PlatformTxt returns the data from the platform.txt configuration file.
-func PlatformTxt() *properties.Map {
-	return platformTxt
+func (projectData *Type) PlatformTxt() *properties.Map {
+	return projectData.platformTxt
 }
 
-var platformTxtLoadError error
-
 // PlatformTxtLoadError returns the error output from loading the platform.txt configuration file.
-func PlatformTxtLoadError() error {
-	return platformTxtLoadError
+func (projectData *Type) PlatformTxtLoadError() error {
+	return projectData.platformTxtLoadError
 }
 
-var platformTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // PlatformTxtSchemaValidationResult returns the result of validating platform.txt against the JSON schema.
-func PlatformTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return platformTxtSchemaValidationResult
+func (projectData *Type) PlatformTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.platformTxtSchemaValidationResult
 }
 
-var platformTxtToolNames []string
-
 // PlatformTxtToolNames returns the list of tools present in the platform's platform.txt.
-func PlatformTxtToolNames() []string {
-	return platformTxtToolNames
+func (projectData *Type) PlatformTxtToolNames() []string {
+	return projectData.platformTxtToolNames
+}
+
+// PlatformPackageIndex returns the packageindex.Index object generated by Arduino CLI from the package index specified
+// by the --package-index flag, or nil if not specified.
+func (projectData *Type) PlatformPackageIndex() *packageindex.Index {
+	return projectData.platformPackageIndex
+}
+
+// PlatformPackageIndexLoadError returns the error output from loading the package index specified by the --package-index
+// flag.
+func (projectData *Type) PlatformPackageIndexLoadError() error {
+	return projectData.platformPackageIndexLoadError
 }
@@ -18,10 +18,14 @@ package projectdata
 import (
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
+	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var platformTestDataPath *paths.Path
@@ -61,17 +65,72 @@ func TestInitializeForPlatform(t *testing.T) {
 			ProjectType:      projecttype.Platform,
 			SuperprojectType: projecttype.Platform,
 		}
-		Initialize(testProject)
+		projectData := Initialize(testProject)
 
-		testTable.boardsTxtLoadErrorAssertion(t, BoardsTxtLoadError(), testTable.testName)
-		if BoardsTxtLoadError() == nil {
-			testTable.boardsTxtAssertion(t, BoardsTxt(), testTable.testName)
+		testTable.boardsTxtLoadErrorAssertion(t, projectData.BoardsTxtLoadError(), testTable.testName)
+		if projectData.BoardsTxtLoadError() == nil {
+			testTable.boardsTxtAssertion(t, projectData.BoardsTxt(), testTable.testName)
 		}
 
-		testTable.platformTxtExistsAssertion(t, PlatformTxtExists(), testTable.testName)
-		testTable.platformTxtAssertion(t, PlatformTxt(), testTable.testName)
-		testTable.platformTxtLoadErrorAssertion(t, PlatformTxtLoadError(), testTable.testName)
-		testTable.platformTxtSchemaValidationResultAssertion(t, PlatformTxtSchemaValidationResult(), testTable.testName)
-		assert.Equal(t, testTable.platformTxtToolNamesAssertion, PlatformTxtToolNames(), testTable.testName)
+		testTable.platformTxtExistsAssertion(t, projectData.PlatformTxtExists(), testTable.testName)
+		testTable.platformTxtAssertion(t, projectData.PlatformTxt(), testTable.testName)
+		testTable.platformTxtLoadErrorAssertion(t, projectData.PlatformTxtLoadError(), testTable.testName)
+		testTable.platformTxtSchemaValidationResultAssertion(t, projectData.PlatformTxtSchemaValidationResult(), testTable.testName)
+		assert.Equal(t, testTable.platformTxtToolNamesAssertion, projectData.PlatformTxtToolNames(), testTable.testName)
 	}
 }
+
+func TestInitializeIndependentProjects(t *testing.T) {
+	validPlatformData := Initialize(project.Type{
+		Path:             platformTestDataPath.Join("valid-platform.txt"),
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.Platform,
+	})
+	invalidPlatformData := Initialize(project.Type{
+		Path:             platformTestDataPath.Join("invalid-platform.txt"),
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.Platform,
+	})
+
+	assert.Nil(t, validPlatformData.PlatformTxtLoadError(), "Data of a project is not affected by initializing another project")
+	assert.NotNil(t, invalidPlatformData.PlatformTxtLoadError())
+	assert.Equal(t, platformTestDataPath.Join("valid-platform.txt"), validPlatformData.ProjectPath())
+
+	validPlatformData.ReportLocation(rulelocation.Type{Path: "platform.txt"})
+	assert.Empty(t, invalidPlatformData.Locations(), "Locations are collected per project")
+	assert.Equal(t, []rulelocation.Type{{Path: "platform.txt"}}, validPlatformData.Locations())
+	assert.Empty(t, validPlatformData.Locations(), "Locations are cleared after retrieval")
+}
+
+func TestInitializeForPlatformPackageIndex(t *testing.T) {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-projectdata-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+	packageIndexPath := temporaryPath.Join("package_foo_index.json")
+	require.Nil(t, packageIndexPath.WriteFile([]byte("{")))
+
+	platformPath := platformTestDataPath.Join("valid-platform.txt")
+	flags := test.ConfigurationFlags()
+	flags.Set("package-index", packageIndexPath.String())
+	require.Nil(t, configuration.Initialize(flags, []string{platformPath.String()}))
+	defer configuration.Initialize(test.ConfigurationFlags(), []string{platformPath.String()})
+
+	platformProject := project.Type{
+		Path:             platformPath,
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.Platform,
+	}
+	projectData := Initialize(platformProject)
+	assert.Nil(t, projectData.PlatformPackageIndex())
+	assert.NotNil(t, projectData.PlatformPackageIndexLoadError())
+
+	validPackageIndexData, err := packageIndexTestDataPath.Join("valid-package-index", "package_foo_index.json").ReadFile()
+	require.Nil(t, err)
+	require.Nil(t, packageIndexPath.WriteFile(validPackageIndexData))
+	projectData = Initialize(platformProject)
+	assert.Nil(t, projectData.PlatformPackageIndexLoadError(), "Load failures are not cached")
+	require.NotNil(t, projectData.PlatformPackageIndex())
+
+	otherProjectData := Initialize(platformProject)
+	assert.Same(t, projectData.PlatformPackageIndex(), otherProjectData.PlatformPackageIndex(), "Package index is only loaded once")
+}
@@ -20,53 +20,127 @@ This is for data required by multiple rules.
 package projectdata
 
 import (
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-lint/internal/project"
-	"github.com/arduino/arduino-lint/internal/project/packageindex"
+	projectpackageindex "github.com/arduino/arduino-lint/internal/project/packageindex"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
+	"github.com/arduino/arduino-lint/internal/rule/schema"
+	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
 	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/client9/misspell"
 )
 
-// Initialize gathers the check data for the specified project.
-func Initialize(project project.Type) {
-	superprojectType = project.SuperprojectType
-	projectType = project.ProjectType
-	projectPath = project.Path
+// Type is the type for the data of a project.
+// Each project has its own instance, so multiple projects can be linted concurrently.
+type Type struct {
+	superprojectType projecttype.Type
+	projectType      projecttype.Type
+	projectPath      *paths.Path
+
+	// Sketch data.
+	sketchLoadError      error
+	loadedSketch         *sketches.Sketch
+	metadataLoadError    error
+	metadataSketchObject *sketches.Sketch
+
+	// Library data.
+	libraryPropertiesLoadError              error
+	libraryProperties                       *properties.Map
+	libraryPropertiesSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
+	loadedLibrary                           *libraries.Library
+	sourceHeaders                           []string
+	libraryManagerIndex                     map[string]interface{}
+	libraryManagerIndexLoadError            error
+	misspelledWordsReplacer                 *misspell.Replacer
+
+	// Platform data.
+	boardsTxt                            *properties.Map
+	boardsTxtLoadError                   error
+	boardsTxtSchemaValidationResult      map[compliancelevel.Type]schema.ValidationResult
+	boardsTxtMenuIds                     []string
+	boardsTxtBoardIds                    []string
+	boardsTxtVisibleBoardIds             []string
+	programmersTxtExists                 bool
+	programmersTxt                       *properties.Map
+	programmersTxtLoadError              error
+	programmersTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
+	programmersTxtProgrammerIds          []string
+	platformTxtExists                    bool
+	platformTxt                          *properties.Map
+	platformTxtLoadError                 error
+	platformTxtSchemaValidationResult    map[compliancelevel.Type]schema.ValidationResult
+	platformTxtToolNames                 []string
+	platformPackageIndex                 *packageindex.Index
+	platformPackageIndexLoadError        error
+
+	// Package index data.
+	packageIndex                       *packageindex.Index
+	packageIndexLoadError              error
+	packageIndexProperties             map[string]interface{}
+	packageIndexPropertiesLoadError    error
+	packageIndexSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
+	packageIndexPackages               []PackageIndexData
+	packageIndexPlatforms              []PackageIndexData
+	packageIndexTools                  []PackageIndexData
+
+	locations []rulelocation.Type
+}
+
+// Initialize gathers the rule data for the specified project.
+func Initialize(project project.Type) *Type {
+	projectData := Type{
+		superprojectType: project.SuperprojectType,
+		projectType:      project.ProjectType,
+		projectPath:      project.Path,
+	}
 	switch project.ProjectType {
 	case projecttype.Sketch:
-		InitializeForSketch(project)
+		projectData.initializeForSketch(project)
 	case projecttype.Library:
-		InitializeForLibrary(project)
+		projectData.initializeForLibrary(project)
 	case projecttype.Platform:
-		InitializeForPlatform(project)
+		projectData.initializeForPlatform(project)
 	case projecttype.PackageIndex:
 		var err error
 		// Because a package index project is a file, but project.Path may be a folder, an extra discovery step is needed for this project type.
-		projectPath, err = packageindex.Find(project.Path)
+		projectData.projectPath, err = projectpackageindex.Find(project.Path)
 		if err != nil {
 			panic(err)
 		}
 
-		InitializeForPackageIndex()
+		projectData.initializeForPackageIndex()
 	}
-}
 
-var superprojectType projecttype.Type
+	return &projectData
+}
 
 // SuperProjectType returns the type of the project being checked.
-func SuperProjectType() projecttype.Type {
-	return superprojectType
+func (projectData *Type) SuperProjectType() projecttype.Type {
+	return projectData.superprojectType
 }
 
-var projectType projecttype.Type
-
 // ProjectType returns the type of the project being checked.
-func ProjectType() projecttype.Type {
-	return projectType
+func (projectData *Type) ProjectType() projecttype.Type {
+	return projectData.projectType
 }
 
-var projectPath *paths.Path
-
 // ProjectPath returns the path to the project being checked.
-func ProjectPath() *paths.Path {
-	return projectPath
+func (projectData *Type) ProjectPath() *paths.Path {
+	return projectData.projectPath
+}
+
+// ReportLocation records the location of a problem found by the rule function currently running on the project.
+func (projectData *Type) ReportLocation(location rulelocation.Type) {
+	projectData.locations = append(projectData.locations, location)
+}
+
+// Locations returns the problem locations reported by the last rule function run on the project and clears the list for the next rule.
+func (projectData *Type) Locations() []rulelocation.Type {
+	reportedLocations := projectData.locations
+	projectData.locations = nil
+	return reportedLocations
 }
@@ -20,41 +20,33 @@ import (
 	"github.com/arduino/arduino-lint/internal/project"
 )
 
-// InitializeForSketch gathers the check data for the specified sketch project.
-func InitializeForSketch(project project.Type) {
-	loadedSketch, sketchLoadError = sketches.NewSketchFromPath(ProjectPath())
+// initializeForSketch gathers the check data for the specified sketch project.
+func (projectData *Type) initializeForSketch(project project.Type) {
+	projectData.loadedSketch, projectData.sketchLoadError = sketches.NewSketchFromPath(projectData.ProjectPath())
 
-	metadataSketchObject := &sketches.Sketch{
-		Name:     ProjectPath().Base(),
-		FullPath: ProjectPath(),
+	projectData.metadataSketchObject = &sketches.Sketch{
+		Name:     projectData.ProjectPath().Base(),
+		FullPath: projectData.ProjectPath(),
 	}
-	metadataLoadError = metadataSketchObject.ImportMetadata()
+	projectData.metadataLoadError = projectData.metadataSketchObject.ImportMetadata()
 }
 
-var sketchLoadError error
-
 // SketchLoadError returns the error output from Arduino CLI loading the sketch.
-func SketchLoadError() error {
-	return sketchLoadError
+func (projectData *Type) SketchLoadError() error {
+	return projectData.sketchLoadError
 }
 
-var loadedSketch *sketches.Sketch
-
 // Sketch returns the sketch object generated by Arduino CLI.
-func Sketch() *sketches.Sketch {
-	return loadedSketch
+func (projectData *Type) Sketch() *sketches.Sketch {
+	return projectData.loadedSketch
 }
 
-var metadataLoadError error
-
 // MetadataLoadError returns the error produced during load of the sketch metadata.
-func MetadataLoadError() error {
-	return metadataLoadError
+func (projectData *Type) MetadataLoadError() error {
+	return projectData.metadataLoadError
 }
 
-var metadataSketchObject *sketches.Sketch
-
 // Metadata returns the metadata object produced by Arduino CLI.
-func Metadata() *sketches.Metadata {
-	return metadataSketchObject.Metadata
+func (projectData *Type) Metadata() *sketches.Metadata {
+	return projectData.metadataSketchObject.Metadata
 }
@@ -0,0 +1,186 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// Support for baseline files, which record existing rule violations so that only new violations cause a failure.
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/go-paths-helper"
+)
+
+// baselineVersion is the version of the baseline file format.
+const baselineVersion = 1
+
+// baselineType is the type for the baseline file data.
+type baselineType struct {
+	Version  int                   `json:"version"`
+	Findings []baselineFindingType `json:"findings"`
+}
+
+// baselineFindingType is the type for a rule violation recorded in the baseline.
+type baselineFindingType struct {
+	Project     string `json:"project"` // Relative to the folder containing the baseline file.
+	RuleID      string `json:"ruleID"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// LoadBaseline loads the baseline file specified by the configuration, if any.
+// Rule violations recorded in the baseline are suppressed by subsequent calls to Record.
+func (results *Type) LoadBaseline() error {
+	baselinePath := configuration.BaselinePath()
+	results.baseline = nil
+	if baselinePath == nil {
+		return nil
+	}
+
+	baselineData, err := baselinePath.ReadFile()
+	if err != nil {
+		return fmt.Errorf("Unable to read baseline file: %v", err)
+	}
+
+	var baseline baselineType
+	if err := json.Unmarshal(baselineData, &baseline); err != nil {
+		return fmt.Errorf("Unable to parse baseline file %s: %v", baselinePath, err)
+	}
+	if baseline.Version != baselineVersion {
+		return fmt.Errorf("Baseline file %s has unsupported version %v", baselinePath, baseline.Version)
+	}
+
+	// The same violation may occur multiple times, so the number of occurrences is tracked.
+	results.baseline = make(map[baselineFindingType]int)
+	for _, finding := range baseline.Findings {
+		results.baseline[finding]++
+	}
+
+	return nil
+}
+
+// WriteBaseline writes a baseline file of the rule violations of all projects to the path specified by the configuration.
+// Violations that were suppressed by a loaded baseline are retained.
+func (results Type) WriteBaseline() error {
+	writeBaselinePath := configuration.WriteBaselinePath()
+
+	baseline := baselineType{
+		Version:  baselineVersion,
+		Findings: []baselineFindingType{},
+	}
+	for _, projectReport := range results.Projects {
+		for _, ruleReport := range projectReport.Rules {
+			if ruleReport.Result != ruleresult.Fail.String() {
+				continue
+			}
+			if ruleReport.Suppression != nil && ruleReport.Suppression.Kind != suppressionKindBaseline {
+				continue // Violations suppressed by other means don't need to be in the baseline.
+			}
+			baseline.Findings = append(baseline.Findings, newBaselineFinding(writeBaselinePath, projectReport.Path, ruleReport))
+		}
+	}
+	// Sort for a deterministic file content, which minimizes the diff when the baseline is updated.
+	sort.SliceStable(baseline.Findings, func(i, j int) bool {
+		if baseline.Findings[i].Project != baseline.Findings[j].Project {
+			return baseline.Findings[i].Project < baseline.Findings[j].Project
+		}
+		if baseline.Findings[i].RuleID != baseline.Findings[j].RuleID {
+			return baseline.Findings[i].RuleID < baseline.Findings[j].RuleID
+		}
+		return baseline.Findings[i].Fingerprint < baseline.Findings[j].Fingerprint
+	})
+
+	baselineData, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		panic(fmt.Sprintf("Error while formatting baseline: %v", err))
+	}
+
+	if err := writeBaselinePath.Parent().MkdirAll(); err != nil {
+		return fmt.Errorf("Unable to create baseline file path (%v): %v", writeBaselinePath.Parent(), err)
+	}
+	if err := writeBaselinePath.WriteFile(append(baselineData, '\n')); err != nil {
+		return fmt.Errorf("While writing baseline: %v", err)
+	}
+
+	return nil
+}
+
+// matchBaseline returns whether the given rule violation is recorded in the loaded baseline.
+// Each recorded occurrence can only be matched once.
+func (results *Type) matchBaseline(projectPath *paths.Path, ruleReport ruleReportType) bool {
+	if results.baseline == nil {
+		return false
+	}
+
+	finding := newBaselineFinding(configuration.BaselinePath(), projectPath, ruleReport)
+	if results.baseline[finding] == 0 {
+		return false
+	}
+	results.baseline[finding]--
+	return true
+}
+
+// newBaselineFinding returns the baseline entry for the given rule violation.
+func newBaselineFinding(baselinePath *paths.Path, projectPath *paths.Path, ruleReport ruleReportType) baselineFindingType {
+	return baselineFindingType{
+		Project:     baselineProjectPath(baselinePath, projectPath),
+		RuleID:      ruleReport.ID,
+		Fingerprint: fingerprint(projectPath, ruleReport),
+	}
+}
+
+// baselineProjectPath returns the project path in the form used in the baseline file.
+// The path is relative to the baseline file so that the baseline can be used on any machine.
+func baselineProjectPath(baselinePath *paths.Path, projectPath *paths.Path) string {
+	absoluteProjectPath, err := projectPath.Abs()
+	if err != nil {
+		absoluteProjectPath = projectPath
+	}
+	absoluteBaselineFolderPath, err := baselinePath.Parent().Abs()
+	if err != nil {
+		return filepath.ToSlash(absoluteProjectPath.String())
+	}
+	relativeProjectPath, err := absoluteProjectPath.RelFrom(absoluteBaselineFolderPath)
+	if err != nil {
+		return filepath.ToSlash(absoluteProjectPath.String())
+	}
+	return filepath.ToSlash(relativeProjectPath.String())
+}
+
+// fingerprint returns a hash that identifies the given rule violation.
+// Line and column numbers are not used because they change whenever unrelated content is added to the file.
+func fingerprint(projectPath *paths.Path, ruleReport ruleReportType) string {
+	// Some rule messages contain paths, which would make the fingerprint dependent on the project location.
+	portableMessage := strings.ReplaceAll(ruleReport.Message, projectPath.String(), "")
+	if absoluteProjectPath, err := projectPath.Abs(); err == nil {
+		portableMessage = strings.ReplaceAll(portableMessage, absoluteProjectPath.String(), "")
+	}
+	portableMessage = filepath.ToSlash(portableMessage)
+
+	fingerprintComponents := []string{ruleReport.ID, portableMessage}
+	for _, locationReport := range ruleReport.Locations {
+		fingerprintComponents = append(fingerprintComponents, locationReport.Path, locationReport.Key)
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(fingerprintComponents, "\x00")))
+	return hex.EncodeToString(hash[:])
+}
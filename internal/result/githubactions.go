@@ -0,0 +1,98 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// Generation of GitHub Actions workflow commands, which are displayed as annotations on the pull request diff.
+// See: https://docs.github.com/en/actions/reference/workflow-commands-for-github-actions
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/go-paths-helper"
+)
+
+// GitHubActionsReport returns GitHub Actions workflow commands that annotate the rule violations of all projects.
+func (results Type) GitHubActionsReport() string {
+	var report strings.Builder
+
+	for _, projectReport := range results.Projects {
+		for _, ruleReport := range projectReport.Rules {
+			if ruleReport.Result != ruleresult.Fail.String() || ruleReport.Suppression != nil {
+				continue
+			}
+
+			command := githubActionsCommand(ruleReport.Level)
+			title := fmt.Sprintf("%s: %s", ruleReport.ID, ruleReport.Brief)
+			locationReports := ruleReport.Locations
+			if len(locationReports) == 0 {
+				// Annotate the project itself.
+				locationReports = []locationReportType{{}}
+			}
+			for _, locationReport := range locationReports {
+				parameters := []string{"file=" + escapeGitHubActionsProperty(githubActionsPath(projectReport.locationPath(locationReport.Path)))}
+				if locationReport.Line > 0 {
+					parameters = append(parameters, fmt.Sprintf("line=%d", locationReport.Line))
+					if locationReport.Column > 0 {
+						parameters = append(parameters, fmt.Sprintf("col=%d", locationReport.Column))
+					}
+				}
+				parameters = append(parameters, "title="+escapeGitHubActionsProperty(title))
+
+				fmt.Fprintf(&report, "::%s %s::%s\n", command, strings.Join(parameters, ","), escapeGitHubActionsData(ruleReport.Message))
+			}
+		}
+	}
+
+	return report.String()
+}
+
+// githubActionsCommand returns the workflow command for the given rule level string.
+func githubActionsCommand(ruleLevel string) string {
+	switch ruleLevel {
+	case rulelevel.Error.String():
+		return "error"
+	case rulelevel.Warning.String():
+		return "warning"
+	default:
+		return "notice"
+	}
+}
+
+// githubActionsPath returns the given path, relative to the working directory where possible.
+// GitHub resolves relative annotation paths against the root of the repository, which is the usual working directory.
+func githubActionsPath(path *paths.Path) string {
+	annotationPath := path.String()
+	if workingDirectoryPath, err := paths.Getwd(); err == nil {
+		if relativePath, err := path.RelFrom(workingDirectoryPath); err == nil && !strings.HasPrefix(relativePath.String(), "..") {
+			annotationPath = relativePath.String()
+		}
+	}
+	return filepath.ToSlash(annotationPath)
+}
+
+// escapeGitHubActionsData escapes the message of a workflow command.
+func escapeGitHubActionsData(data string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(data)
+}
+
+// escapeGitHubActionsProperty escapes a parameter value of a workflow command.
+func escapeGitHubActionsProperty(property string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(property)
+}
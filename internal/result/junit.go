@@ -0,0 +1,154 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// Generation of reports in the JUnit XML format, for display by CI systems.
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+)
+
+// junitTestSuitesType is the type for the top level JUnit element.
+type junitTestSuitesType struct {
+	XMLName    xml.Name             `xml:"testsuites"`
+	Name       string               `xml:"name,attr"`
+	Tests      int                  `xml:"tests,attr"`
+	Failures   int                  `xml:"failures,attr"`
+	Skipped    int                  `xml:"skipped,attr"`
+	TestSuites []junitTestSuiteType `xml:"testsuite"`
+}
+
+// junitTestSuiteType is the type for the results of a project.
+type junitTestSuiteType struct {
+	Name      string              `xml:"name,attr"`
+	Tests     int                 `xml:"tests,attr"`
+	Failures  int                 `xml:"failures,attr"`
+	Skipped   int                 `xml:"skipped,attr"`
+	TestCases []junitTestCaseType `xml:"testcase"`
+}
+
+// junitTestCaseType is the type for the result of a rule.
+type junitTestCaseType struct {
+	Name      string              `xml:"name,attr"`
+	ClassName string              `xml:"classname,attr"`
+	Failure   *junitFailureType   `xml:"failure,omitempty"`
+	Skipped   *junitSkippedType   `xml:"skipped,omitempty"`
+	SystemOut *junitSystemOutType `xml:"system-out,omitempty"`
+}
+
+// junitFailureType is the type for an error level rule violation.
+type junitFailureType struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitSkippedType is the type for a rule that was not run or was suppressed.
+type junitSkippedType struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// junitSystemOutType is the type for the output of a rule that did not fail at error level.
+type junitSystemOutType struct {
+	Text string `xml:",chardata"`
+}
+
+// JUnitReport returns a JUnit XML formatted report of rules on all projects in string encoding.
+func (results Type) JUnitReport() string {
+	return string(results.junitReportRaw())
+}
+
+// junitReportRaw returns the report marshalled into JUnit XML format in byte encoding.
+func (results Type) junitReportRaw() []byte {
+	testSuites := junitTestSuitesType{
+		Name:       "arduino-lint",
+		TestSuites: []junitTestSuiteType{},
+	}
+
+	for _, projectReport := range results.Projects {
+		testSuite := junitTestSuiteType{
+			Name:      projectReport.Path.String(),
+			TestCases: []junitTestCaseType{},
+		}
+
+		for _, ruleReport := range projectReport.Rules {
+			testCase := junitTestCaseType{
+				Name:      fmt.Sprintf("%s: %s", ruleReport.ID, ruleReport.Brief),
+				ClassName: strings.Join([]string{projectReport.ProjectType, ruleReport.Category, ruleReport.Subcategory}, "."),
+			}
+
+			switch {
+			case ruleReport.Result == ruleresult.Skip.String() || ruleReport.Result == ruleresult.NotRun.String():
+				testCase.Skipped = &junitSkippedType{Message: ruleReport.Message}
+			case ruleReport.Result == ruleresult.Fail.String() && ruleReport.Suppression != nil:
+				testCase.Skipped = &junitSkippedType{Message: fmt.Sprintf("Suppressed (%s): %s", ruleReport.Suppression.Kind, ruleReport.Message)}
+			case ruleReport.Result == ruleresult.Fail.String() && ruleReport.Level == rulelevel.Error.String():
+				testCase.Failure = &junitFailureType{
+					Message: ruleReport.Message,
+					Type:    ruleReport.Level,
+					Text:    junitFailureText(ruleReport),
+				}
+			case ruleReport.Message != "":
+				// Warning and info level failures, as well as explanations of passing results.
+				testCase.SystemOut = &junitSystemOutType{Text: fmt.Sprintf("%s: %s", ruleReport.Level, ruleReport.Message)}
+			}
+
+			testSuite.Tests++
+			if testCase.Failure != nil {
+				testSuite.Failures++
+			}
+			if testCase.Skipped != nil {
+				testSuite.Skipped++
+			}
+			testSuite.TestCases = append(testSuite.TestCases, testCase)
+		}
+
+		testSuites.Tests += testSuite.Tests
+		testSuites.Failures += testSuite.Failures
+		testSuites.Skipped += testSuite.Skipped
+		testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+	}
+
+	var marshalledReportBuffer bytes.Buffer
+	marshalledReportBuffer.WriteString(xml.Header)
+	xmlEncoder := xml.NewEncoder(&marshalledReportBuffer)
+	xmlEncoder.Indent("", "  ")
+	if err := xmlEncoder.Encode(testSuites); err != nil {
+		panic(fmt.Sprintf("Error while formatting JUnit report: %v", err))
+	}
+	marshalledReportBuffer.WriteString("\n")
+
+	return marshalledReportBuffer.Bytes()
+}
+
+// junitFailureText returns the detailed description of a rule violation for use as the body of the JUnit failure element.
+func junitFailureText(ruleReport ruleReportType) string {
+	failureLines := []string{ruleReport.Message}
+	for _, locationReport := range ruleReport.Locations {
+		failureLines = append(failureLines, "at "+locationReport.location().String())
+	}
+	if ruleReport.Description != "" {
+		failureLines = append(failureLines, "", ruleReport.Description)
+	}
+
+	return strings.Join(failureLines, "\n")
+}
@@ -26,15 +26,21 @@ import (
 type Type int
 
 const (
-	Text Type = iota // text
-	JSON             // json
+	Text          Type = iota // text
+	JSON                      // json
+	SARIF                     // sarif
+	JUnit                     // junit
+	GitHubActions             // github-actions
 )
 
 // FromString parses the --format flag value and returns the corresponding output format type.
 func FromString(outputFormatString string) (Type, error) {
 	formatType, found := map[string]Type{
-		Text.String(): Text,
-		JSON.String(): JSON,
+		Text.String():          Text,
+		JSON.String():          JSON,
+		SARIF.String():         SARIF,
+		JUnit.String():         JUnit,
+		GitHubActions.String(): GitHubActions,
 	}[strings.ToLower(outputFormatString)]
 
 	if found {
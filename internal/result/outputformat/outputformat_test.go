@@ -29,6 +29,9 @@ func TestFromString(t *testing.T) {
 	}{
 		{"text", Text, assert.NoError},
 		{"json", JSON, assert.NoError},
+		{"sarif", SARIF, assert.NoError},
+		{"junit", JUnit, assert.NoError},
+		{"github-actions", GitHubActions, assert.NoError},
 		{"TEXT", Text, assert.NoError},
 		{"foo", 0, assert.Error},
 	}
@@ -10,11 +10,14 @@ func _() {
 	var x [1]struct{}
 	_ = x[Text-0]
 	_ = x[JSON-1]
+	_ = x[SARIF-2]
+	_ = x[JUnit-3]
+	_ = x[GitHubActions-4]
 }
 
-const _Type_name = "textjson"
+const _Type_name = "textjsonsarifjunitgithub-actions"
 
-var _Type_index = [...]uint8{0, 4, 8}
+var _Type_index = [...]uint8{0, 4, 8, 13, 18, 32}
 
 func (i Type) String() string {
 	if i < 0 || i >= Type(len(_Type_index)-1) {
@@ -21,13 +21,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
 	"text/template"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/configuration/failon"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/fix/change"
 	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/result/outputformat"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
 	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/go-paths-helper"
 )
@@ -40,6 +46,7 @@ type Type struct {
 	Configuration toolConfigurationReportType `json:"configuration"`
 	Projects      []projectReportType         `json:"projects"`
 	Summary       summaryReportType           `json:"summary"`
+	baseline      map[baselineFindingType]int // Occurrences of the rule violations in the loaded baseline that have not yet been matched.
 }
 
 // toolConfigurationReportType is the type for the Arduino Lint tool configuration.
@@ -54,8 +61,21 @@ type projectReportType struct {
 	Path          *paths.Path                    `json:"path"`
 	ProjectType   string                         `json:"projectType"`
 	Configuration projectConfigurationReportType `json:"configuration"`
+	Changes       []changeReportType             `json:"changes,omitempty"`
+	Diff          string                         `json:"diff,omitempty"` // Unified diff of the edits proposed in dry run mode.
 	Rules         []ruleReportType               `json:"rules"`
 	Summary       summaryReportType              `json:"summary"`
+
+	lintedPath *paths.Path // The path the project was linted at, which differs from Path for projects in an archive.
+}
+
+// changeReportType is the type of the reports of the changes made to a project by the fixes.
+type changeReportType struct {
+	Kind        string `json:"kind"`              // Can be {edit|rename}.
+	Path        string `json:"path"`              // Relative to the project path.
+	NewPath     string `json:"newPath,omitempty"` // Relative to the project path.
+	Description string `json:"description"`
+	Applied     bool   `json:"applied"` // False in dry run mode.
 }
 
 // projectConfigurationReportType is the type for the individual project tool configurations.
@@ -67,35 +87,69 @@ type projectConfigurationReportType struct {
 
 // ruleReportType is the type of the rule reports.
 type ruleReportType struct {
-	Category    string `json:"category"`
-	Subcategory string `json:"subcategory"`
-	ID          string `json:"ID"`
-	Brief       string `json:"brief"`
-	Description string `json:"description"`
-	Result      string `json:"result"`
-	Level       string `json:"level"`
-	Message     string `json:"message"`
+	Category    string                 `json:"category"`
+	Subcategory string                 `json:"subcategory"`
+	ID          string                 `json:"ID"`
+	Brief       string                 `json:"brief"`
+	Description string                 `json:"description"`
+	Result      string                 `json:"result"`
+	Level       string                 `json:"level"`
+	Message     string                 `json:"message"`
+	Locations   []locationReportType   `json:"locations"`
+	Suppression *suppressionReportType `json:"suppression,omitempty"`
+}
+
+// locationReportType is the type of the reports of the locations of rule violations.
+type locationReportType struct {
+	Path   string `json:"path"` // Relative to the project path.
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	Key    string `json:"key,omitempty"`
+}
+
+// location returns the rule violation location of the report.
+func (locationReport locationReportType) location() rulelocation.Type {
+	return rulelocation.Type{Path: locationReport.Path, Line: locationReport.Line, Column: locationReport.Column, Key: locationReport.Key}
+}
+
+// Kinds of rule violation suppressions.
+const (
+	suppressionKindBaseline = "baseline" // The violation is recorded in the baseline file.
+	suppressionKindInSource = "inSource" // The violation is suppressed by a comment in the project files.
+)
+
+// suppressionReportType is the type of the reports of suppressed rule violations.
+// Suppressed violations do not affect the rule result summary.
+type suppressionReportType struct {
+	Kind          string `json:"kind"` // Can be {baseline|inSource}.
+	Justification string `json:"justification,omitempty"`
 }
 
 // summaryReportType is the type of the rule result summary reports.
 type summaryReportType struct {
-	Pass         bool `json:"pass"`
-	WarningCount int  `json:"warningCount"`
-	ErrorCount   int  `json:"errorCount"`
+	Pass            bool `json:"pass"` // Whether the results pass according to the --fail-on and --max-warnings settings.
+	InfoCount       int  `json:"infoCount"`
+	WarningCount    int  `json:"warningCount"`
+	ErrorCount      int  `json:"errorCount"`
+	SuppressedCount int  `json:"suppressedCount"`
 }
 
 // Initialize adds the tool configuration data to the results data.
 func (results *Type) Initialize() {
 	*results = *new(Type)
+	var reportPaths paths.PathList
+	for _, targetPath := range configuration.TargetPaths() {
+		reportPaths.Add(configuration.ReportPath(targetPath))
+	}
 	results.Configuration = toolConfigurationReportType{
-		Paths:       configuration.TargetPaths(),
+		Paths:       reportPaths,
 		ProjectType: configuration.SuperprojectTypeFilter().String(),
 		Recursive:   configuration.Recursive(),
 	}
 }
 
 // Record records the result of a rule and returns a text summary for it.
-func (results *Type) Record(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, ruleOutput string) string {
+func (results *Type) Record(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, ruleOutput string, ruleLocations []rulelocation.Type) string {
 	ruleLevel, err := rulelevel.RuleLevel(ruleConfiguration, ruleResult, lintedProject)
 	if err != nil {
 		panic(fmt.Errorf("Error while determining rule level: %v", err))
@@ -111,45 +165,104 @@ func (results *Type) Record(lintedProject project.Type, ruleConfiguration ruleco
 		// The message template should not be used in this case, since it is written for a failure result.
 		ruleMessage = ruleOutput
 	}
+	reportPath := configuration.ReportPath(lintedProject.Path)
+	if reportPath != lintedProject.Path {
+		ruleMessage = strings.ReplaceAll(ruleMessage, lintedProject.Path.String(), reportPath.String())
+	}
+
+	ruleReport := ruleReportType{
+		Category:    ruleConfiguration.Category,
+		Subcategory: ruleConfiguration.Subcategory,
+		ID:          ruleConfiguration.ID,
+		Brief:       ruleConfiguration.Brief,
+		Description: ruleConfiguration.Description,
+		Result:      ruleResult.String(),
+		Level:       ruleLevel.String(),
+		Message:     ruleMessage,
+		Locations:   []locationReportType{},
+	}
+	for _, ruleLocation := range ruleLocations {
+		ruleReport.Locations = append(
+			ruleReport.Locations,
+			locationReportType{
+				Path:   ruleLocation.Path,
+				Line:   ruleLocation.Line,
+				Column: ruleLocation.Column,
+				Key:    ruleLocation.Key,
+			},
+		)
+	}
+
+	if ruleResult == ruleresult.Fail {
+		if suppression := inSourceSuppression(lintedProject, ruleReport); suppression != nil {
+			ruleReport.Suppression = suppression
+			summaryText += fmt.Sprintf(" (suppressed: %s)", suppression.Justification)
+		} else if results.matchBaseline(reportPath, ruleReport) {
+			ruleReport.Suppression = &suppressionReportType{Kind: suppressionKindBaseline}
+			summaryText += " (suppressed by baseline)"
+		}
+	}
 
 	// Add explanation of rule result if present.
 	if ruleMessage != "" {
 		summaryText += fmt.Sprintf("\n%s: %s", ruleLevel, ruleMessage)
 	}
 
+	projectReportIndex := results.addProjectReport(lintedProject)
+
+	// The JUnit format represents every rule that was run as a test case.
+	if (ruleResult == ruleresult.Fail) || configuration.Verbose() || configuration.OutputFormat() == outputformat.JUnit {
+		results.Projects[projectReportIndex].Rules = append(results.Projects[projectReportIndex].Rules, ruleReport)
+	}
+
+	return summaryText
+}
+
+// RecordChanges records the changes made to the given project by the fixes, and the unified diff of the edits in dry run
+// mode.
+func (results *Type) RecordChanges(fixedProject project.Type, changes []change.Type, diff string) {
+	if len(changes) == 0 {
+		return
+	}
+
+	projectReportIndex := results.addProjectReport(fixedProject)
+	results.Projects[projectReportIndex].Diff = diff
+	for _, projectChange := range changes {
+		results.Projects[projectReportIndex].Changes = append(
+			results.Projects[projectReportIndex].Changes,
+			changeReportType{
+				Kind:        projectChange.Kind,
+				Path:        projectChange.Path,
+				NewPath:     projectChange.NewPath,
+				Description: projectChange.Description,
+				Applied:     !configuration.DryRun(),
+			},
+		)
+	}
+}
+
+// addProjectReport adds a report for the given project, if there is not already one, and returns its index.
+func (results *Type) addProjectReport(lintedProject project.Type) int {
 	reportExists, projectReportIndex := results.getProjectReportIndex(lintedProject.Path)
 	if !reportExists {
 		// There is no existing report for this project.
 		results.Projects = append(
 			results.Projects,
 			projectReportType{
-				Path:        lintedProject.Path,
+				Path:        configuration.ReportPath(lintedProject.Path),
 				ProjectType: lintedProject.ProjectType.String(),
 				Configuration: projectConfigurationReportType{
 					Compliance:     rulemode.Compliance(configuration.RuleModes(lintedProject.ProjectType)),
 					LibraryManager: rulemode.LibraryManager(configuration.RuleModes(lintedProject.ProjectType)),
 					Official:       configuration.RuleModes(lintedProject.ProjectType)[rulemode.Official],
 				},
-				Rules: []ruleReportType{},
+				Rules:      []ruleReportType{},
+				lintedPath: lintedProject.Path,
 			},
 		)
 	}
 
-	if (ruleResult == ruleresult.Fail) || configuration.Verbose() {
-		ruleReport := ruleReportType{
-			Category:    ruleConfiguration.Category,
-			Subcategory: ruleConfiguration.Subcategory,
-			ID:          ruleConfiguration.ID,
-			Brief:       ruleConfiguration.Brief,
-			Description: ruleConfiguration.Description,
-			Result:      ruleResult.String(),
-			Level:       ruleLevel.String(),
-			Message:     ruleMessage,
-		}
-		results.Projects[projectReportIndex].Rules = append(results.Projects[projectReportIndex].Rules, ruleReport)
-	}
-
-	return summaryText
+	return projectReportIndex
 }
 
 // AddProjectSummary summarizes the results of all rules on the given project and adds it to the report.
@@ -159,24 +272,42 @@ func (results *Type) AddProjectSummary(lintedProject project.Type) {
 		panic(fmt.Sprintf("Unable to find report for %v when generating report summary", lintedProject.Path))
 	}
 
-	pass := true
+	infoCount := 0
 	warningCount := 0
 	errorCount := 0
+	suppressedCount := 0
 	for _, ruleReport := range results.Projects[projectReportIndex].Rules {
 		if ruleReport.Result == ruleresult.Fail.String() {
-			if ruleReport.Level == rulelevel.Warning.String() {
+			if ruleReport.Suppression != nil {
+				suppressedCount += 1
+			} else if ruleReport.Level == rulelevel.Info.String() {
+				infoCount += 1
+			} else if ruleReport.Level == rulelevel.Warning.String() {
 				warningCount += 1
 			} else if ruleReport.Level == rulelevel.Error.String() {
 				errorCount += 1
-				pass = false
 			}
 		}
 	}
 
+	var pass bool
+	switch configuration.FailOn() {
+	case failon.Error:
+		pass = errorCount == 0
+	case failon.Warning:
+		pass = errorCount == 0 && warningCount == 0
+	case failon.Info:
+		pass = errorCount == 0 && warningCount == 0 && infoCount == 0
+	case failon.Never:
+		pass = true
+	}
+
 	results.Projects[projectReportIndex].Summary = summaryReportType{
-		Pass:         pass,
-		WarningCount: warningCount,
-		ErrorCount:   errorCount,
+		Pass:            pass,
+		InfoCount:       infoCount,
+		WarningCount:    warningCount,
+		ErrorCount:      errorCount,
+		SuppressedCount: suppressedCount,
 	}
 }
 
@@ -194,20 +325,31 @@ func (results Type) ProjectSummaryText(lintedProject project.Type) string {
 // AddSummary summarizes the rule results for all projects and adds it to the report.
 func (results *Type) AddSummary() {
 	pass := true
+	infoCount := 0
 	warningCount := 0
 	errorCount := 0
+	suppressedCount := 0
 	for _, projectReport := range results.Projects {
 		if !projectReport.Summary.Pass {
 			pass = false
 		}
+		infoCount += projectReport.Summary.InfoCount
 		warningCount += projectReport.Summary.WarningCount
 		errorCount += projectReport.Summary.ErrorCount
+		suppressedCount += projectReport.Summary.SuppressedCount
+	}
+
+	// The warning limit applies to the cumulative count, since it is a policy for the whole run.
+	if configuration.MaxWarnings() >= 0 && warningCount > configuration.MaxWarnings() {
+		pass = false
 	}
 
 	results.Summary = summaryReportType{
-		Pass:         pass,
-		WarningCount: warningCount,
-		ErrorCount:   errorCount,
+		Pass:            pass,
+		InfoCount:       infoCount,
+		WarningCount:    warningCount,
+		ErrorCount:      errorCount,
+		SuppressedCount: suppressedCount,
 	}
 }
 
@@ -237,6 +379,18 @@ func (results Type) jsonReportRaw() []byte {
 	return marshalledReportBuffer.Bytes()
 }
 
+// reportRaw returns the report in the machine readable format appropriate for the output format configuration.
+func (results Type) reportRaw() []byte {
+	switch configuration.OutputFormat() {
+	case outputformat.SARIF:
+		return results.sarifReportRaw()
+	case outputformat.JUnit:
+		return results.junitReportRaw()
+	default:
+		return results.jsonReportRaw()
+	}
+}
+
 // WriteReport writes a report for all projects to the specified file.
 func (results Type) WriteReport() error {
 	reportFilePath := configuration.ReportFilePath()
@@ -251,7 +405,7 @@ func (results Type) WriteReport() error {
 		}
 	}
 
-	err = reportFilePath.WriteFile(results.jsonReportRaw())
+	err = reportFilePath.WriteFile(results.reportRaw())
 	if err != nil {
 		return fmt.Errorf("While writing report: %v", err)
 	}
@@ -259,7 +413,31 @@ func (results Type) WriteReport() error {
 	return nil
 }
 
-// Passed returns whether the rules passed cumulatively.
+// Findings returns descriptions of the unsuppressed rule violations of the given project, in the form
+// `LEVEL ID location: message`.
+func (results Type) Findings(lintedProject project.Type) []string {
+	findings := []string{}
+	reportExists, projectReportIndex := results.getProjectReportIndex(lintedProject.Path)
+	if !reportExists {
+		return findings
+	}
+
+	for _, ruleReport := range results.Projects[projectReportIndex].Rules {
+		if ruleReport.Result != ruleresult.Fail.String() || ruleReport.Suppression != nil {
+			continue
+		}
+
+		finding := fmt.Sprintf("%s %s", ruleReport.Level, ruleReport.ID)
+		for _, locationReport := range ruleReport.Locations {
+			finding += " " + locationReport.location().String()
+		}
+		findings = append(findings, fmt.Sprintf("%s: %s", finding, ruleReport.Message))
+	}
+
+	return findings
+}
+
+// Passed returns whether the rules passed cumulatively, according to the --fail-on and --max-warnings settings.
 func (results Type) Passed() bool {
 	return results.Summary.Pass
 }
@@ -269,7 +447,7 @@ func (results Type) getProjectReportIndex(projectPath *paths.Path) (bool, int) {
 	var index int
 	var projectReport projectReportType
 	for index, projectReport = range results.Projects {
-		if projectReport.Path == projectPath {
+		if projectReport.lintedPath == projectPath {
 			return true, index
 		}
 	}
@@ -288,3 +466,17 @@ func message(templateText string, ruleOutput string) string {
 
 	return messageBuffer.String()
 }
+
+// locationPath returns the path of the given location in the project.
+func (projectReport projectReportType) locationPath(locationPath string) *paths.Path {
+	if locationPath == "" {
+		return projectReport.Path
+	}
+
+	folderPath := projectReport.Path
+	if projectReport.lintedPath.IsNotDir() {
+		// Package index projects may be files. Location paths are relative to the containing folder.
+		folderPath = folderPath.Parent()
+	}
+	return folderPath.Join(filepath.FromSlash(locationPath))
+}
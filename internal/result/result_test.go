@@ -16,17 +16,24 @@
 package result
 
 import (
+	"archive/zip"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/fix/change"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
 	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
@@ -74,18 +81,18 @@ func TestRecord(t *testing.T) {
 	results.Initialize()
 	ruleConfiguration := ruleconfiguration.Configurations()[0]
 	ruleOutput := "foo"
-	summaryText := results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput)
+	summaryText := results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, nil)
 	assert.Equal(t, fmt.Sprintf("Rule %s result: %s\n%s: %s", ruleConfiguration.ID, ruleresult.Fail, rulelevel.Error, message(ruleConfiguration.MessageTemplate, ruleOutput)), summaryText)
-	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.NotRun, ruleOutput)
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.NotRun, ruleOutput, nil)
 	assert.Equal(t, fmt.Sprintf("Rule %s result: %s\n%s: %s", ruleConfiguration.ID, ruleresult.NotRun, rulelevel.Notice, ruleOutput), summaryText, "Non-fail result should not use message")
-	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "")
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "", nil)
 	assert.Equal(t, "", "", summaryText, "Non-failure result with no rule function output should result in an empty summary")
 
 	flags.Set("verbose", "true")
 	require.Nil(t, configuration.Initialize(flags, projectPaths))
 	ruleResult := ruleresult.Pass
 	results.Initialize()
-	results.Record(lintedProject, ruleConfiguration, ruleResult, ruleOutput)
+	results.Record(lintedProject, ruleConfiguration, ruleResult, ruleOutput, nil)
 	projectReport := results.Projects[0]
 	assert.Equal(t, lintedProject.Path, projectReport.Path)
 	assert.Equal(t, lintedProject.ProjectType.String(), projectReport.ProjectType)
@@ -108,25 +115,104 @@ func TestRecord(t *testing.T) {
 	flags.Set("verbose", "false")
 	require.Nil(t, configuration.Initialize(flags, projectPaths))
 	results.Initialize()
-	results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, ruleOutput)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, ruleOutput, nil)
 	assert.Equal(t, 0, len(results.Projects[0].Rules), "Passing rule reports should not be written to report in non-verbose mode")
 
 	results.Initialize()
-	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, nil)
 	require.Equal(t, 1, len(projectReport.Rules), "Failing rule reports should be written to report in non-verbose mode")
 
 	assert.Len(t, results.Projects, 1)
 	previousProjectPath := lintedProject.Path
 	lintedProject.Path = paths.New("/foo/baz")
-	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, nil)
 	assert.Len(t, results.Projects, 2)
 
 	assert.Len(t, results.Projects[0].Rules, 1)
 	lintedProject.Path = previousProjectPath
-	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Fail, ruleOutput)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Fail, ruleOutput, nil)
 	assert.Len(t, results.Projects[0].Rules, 2)
 }
 
+func TestRecordChanges(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	flags.Set("fix", "true")
+	flags.Set("dry-run", "true")
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+
+	fixedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+	renameChange := change.Type{Kind: change.Rename, Path: "bar.pde", NewPath: "bar.ino", Description: "Renamed bar.pde to bar.ino"}
+
+	var results Type
+	results.Initialize()
+	results.RecordChanges(fixedProject, nil, "")
+	assert.Empty(t, results.Projects, "No report without changes")
+
+	results.RecordChanges(fixedProject, []change.Type{renameChange}, "--- a/foo\n+++ b/foo\n")
+	require.Len(t, results.Projects, 1)
+	assert.Equal(t, []changeReportType{{Kind: "rename", Path: "bar.pde", NewPath: "bar.ino", Description: "Renamed bar.pde to bar.ino", Applied: false}}, results.Projects[0].Changes)
+	assert.Equal(t, "--- a/foo\n+++ b/foo\n", results.Projects[0].Diff)
+
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	results.Record(fixedProject, ruleConfiguration, ruleresult.Fail, "", nil)
+	require.Len(t, results.Projects, 1, "Rule results are recorded in the same project report")
+	assert.Len(t, results.Projects[0].Rules, 1)
+
+	flags.Set("dry-run", "false")
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	results.Initialize()
+	results.RecordChanges(fixedProject, []change.Type{renameChange}, "")
+	assert.Empty(t, results.Projects[0].Diff)
+	assert.True(t, results.Projects[0].Changes[0].Applied)
+}
+
+func TestRecordArchive(t *testing.T) {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-result-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+
+	archivePath := temporaryPath.Join("Foo-1.0.0.zip")
+	archiveFile, err := archivePath.Create()
+	require.Nil(t, err)
+	zipWriter := zip.NewWriter(archiveFile)
+	_, err = zipWriter.Create("Foo/library.properties")
+	require.Nil(t, err)
+	require.Nil(t, zipWriter.Close())
+	require.Nil(t, archiveFile.Close())
+
+	flags := test.ConfigurationFlags()
+	flags.Set("format", "github-actions")
+	require.Nil(t, configuration.Initialize(flags, []string{archivePath.String()}))
+	defer configuration.RemoveArchiveExtractions()
+
+	lintedProject := project.Type{
+		Path:             configuration.TargetPaths()[0],
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	ruleConfiguration := ruleconfiguration.Type{
+		ProjectType:     projecttype.Library,
+		ID:              "XX001",
+		Brief:           "foo",
+		MessageTemplate: "{{.}}",
+		WarningModes:    []rulemode.Type{rulemode.Default},
+	}
+
+	var results Type
+	results.Initialize()
+	assert.Equal(t, paths.NewPathList(archivePath.Join("Foo").String()), results.Configuration.Paths, "Target paths are relative to the archive")
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "Problem in "+lintedProject.Path.Join("library.properties").String(), []rulelocation.Type{{Path: "library.properties"}})
+	require.Len(t, results.Projects, 1)
+	assert.Equal(t, archivePath.Join("Foo").String(), results.Projects[0].Path.String(), "Project path is relative to the archive")
+	assert.Equal(t, "Problem in "+archivePath.Join("Foo", "library.properties").String(), results.Projects[0].Rules[0].Message, "Paths in message are relative to the archive")
+	assert.Contains(t, results.GitHubActionsReport(), "file="+escapeGitHubActionsProperty(filepath.ToSlash(archivePath.Join("Foo", "library.properties").String())))
+}
+
 func TestAddProjectSummary(t *testing.T) {
 	lintedProject := project.Type{
 		Path:             paths.New("/foo/bar"),
@@ -186,7 +272,7 @@ func TestAddProjectSummary(t *testing.T) {
 
 		ruleIndex := 0
 		for testDataIndex, result := range testTable.results {
-			results.Record(lintedProject, ruleconfiguration.Configurations()[0], result, "")
+			results.Record(lintedProject, ruleconfiguration.Configurations()[0], result, "", nil)
 			if (result == ruleresult.Fail) || configuration.Verbose() {
 				level := testTable.levels[testDataIndex].String()
 				results.Projects[0].Rules[ruleIndex].Level = level
@@ -271,7 +357,7 @@ func TestAddSummary(t *testing.T) {
 		var results Type
 		for projectIndex, projectSummary := range testTable.projectSummaries {
 			lintedProject.Path = paths.New(fmt.Sprintf("/foo/bar%v", projectIndex)) // Use a unique path to generate a new project report.
-			results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "")
+			results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "", nil)
 			results.AddProjectSummary(lintedProject)
 			results.Projects[projectIndex].Summary = projectSummary
 		}
@@ -284,6 +370,84 @@ func TestAddSummary(t *testing.T) {
 	}
 }
 
+func TestFailOn(t *testing.T) {
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Library,
+	}
+
+	testTables := []struct {
+		levels       []rulelevel.Type
+		failOn       string
+		expectedPass bool
+	}{
+		{[]rulelevel.Type{rulelevel.Warning}, "error", true},
+		{[]rulelevel.Type{rulelevel.Error}, "error", false},
+		{[]rulelevel.Type{rulelevel.Info}, "warning", true},
+		{[]rulelevel.Type{rulelevel.Warning}, "warning", false},
+		{[]rulelevel.Type{rulelevel.Info}, "info", false},
+		{[]rulelevel.Type{rulelevel.Error, rulelevel.Warning}, "never", true},
+	}
+
+	for _, testTable := range testTables {
+		flags := test.ConfigurationFlags()
+		flags.Set("fail-on", testTable.failOn)
+		require.Nil(t, configuration.Initialize(flags, projectPaths))
+
+		var results Type
+		results.Initialize()
+		for ruleIndex, level := range testTable.levels {
+			results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", nil)
+			results.Projects[0].Rules[ruleIndex].Level = level.String()
+		}
+		results.AddProjectSummary(lintedProject)
+		results.AddSummary()
+		assert.Equal(t, testTable.expectedPass, results.Passed(), "%v, --fail-on %s", testTable.levels, testTable.failOn)
+		if testTable.levels[0] == rulelevel.Info {
+			assert.Equal(t, 1, results.Summary.InfoCount)
+		}
+	}
+}
+
+func TestMaxWarnings(t *testing.T) {
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Library,
+	}
+
+	testTables := []struct {
+		maxWarnings  string
+		expectedPass bool
+	}{
+		{"-1", true},
+		{"3", true},
+		{"2", false},
+		{"0", false},
+	}
+
+	for _, testTable := range testTables {
+		flags := test.ConfigurationFlags()
+		flags.Set("max-warnings", testTable.maxWarnings)
+		require.Nil(t, configuration.Initialize(flags, projectPaths))
+
+		var results Type
+		results.Initialize()
+		for projectIndex, warningCount := range []int{1, 2} {
+			lintedProject.Path = paths.New(fmt.Sprintf("/foo/bar%v", projectIndex)) // Use a unique path to generate a new project report.
+			results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "", nil)
+			results.AddProjectSummary(lintedProject)
+			results.Projects[projectIndex].Summary = summaryReportType{Pass: true, WarningCount: warningCount}
+		}
+		results.AddSummary()
+		assert.Equal(t, 3, results.Summary.WarningCount)
+		assert.Equal(t, testTable.expectedPass, results.Passed(), "--max-warnings %s", testTable.maxWarnings)
+	}
+
+	require.Nil(t, configuration.Initialize(test.ConfigurationFlags(), projectPaths))
+}
+
 func TestWriteReport(t *testing.T) {
 	flags := test.ConfigurationFlags()
 
@@ -314,3 +478,347 @@ func TestWriteReport(t *testing.T) {
 	require.Nil(t, err)
 	assert.True(t, assert.ObjectsAreEqualValues(reportFileBytes, Results.jsonReportRaw()), "Report file contents are correct")
 }
+
+func TestSARIFReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]).Join("foo"),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize()
+	failedRuleConfiguration := ruleconfiguration.Configurations()[1]
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "", nil)
+	results.Record(lintedProject, failedRuleConfiguration, ruleresult.Fail, "bar", nil)
+
+	var sarifLog sarifLogType
+	require.Nil(t, json.Unmarshal([]byte(results.SARIFReport()), &sarifLog))
+	assert.Equal(t, "2.1.0", sarifLog.Version)
+	require.Len(t, sarifLog.Runs, 1)
+	assert.Len(t, sarifLog.Runs[0].Tool.Driver.Rules, len(ruleconfiguration.Configurations()), "All rules should be described")
+	require.Len(t, sarifLog.Runs[0].Results, 1, "Only failures should be reported as results")
+	sarifResult := sarifLog.Runs[0].Results[0]
+	assert.Equal(t, failedRuleConfiguration.ID, sarifResult.RuleID)
+	assert.Equal(t, failedRuleConfiguration.ID, sarifLog.Runs[0].Tool.Driver.Rules[sarifResult.RuleIndex].ID)
+	assert.Equal(t, failedRuleConfiguration.Brief, sarifLog.Runs[0].Tool.Driver.Rules[sarifResult.RuleIndex].ShortDescription.Text)
+	assert.Equal(t, "error", sarifResult.Level)
+	assert.Equal(t, message(failedRuleConfiguration.MessageTemplate, "bar"), sarifResult.Message.Text)
+	assert.Equal(t, "foo", sarifResult.Locations[0].PhysicalLocation.ArtifactLocation.URI, "Paths under the working directory should be relative")
+	assert.Nil(t, sarifResult.Locations[0].PhysicalLocation.Region)
+
+	lintedProject.Path = paths.New(projectPaths[0]) // Location paths are relative to the project folder, which must exist.
+	results.Initialize()
+	results.Record(lintedProject, failedRuleConfiguration, ruleresult.Fail, "bar", []rulelocation.Type{{Path: "library.properties", Line: 3, Column: 1, Key: "version"}})
+	require.Nil(t, json.Unmarshal([]byte(results.SARIFReport()), &sarifLog))
+	sarifLocation := sarifLog.Runs[0].Results[0].Locations[0]
+	assert.Equal(t, "library.properties", sarifLocation.PhysicalLocation.ArtifactLocation.URI)
+	require.NotNil(t, sarifLocation.PhysicalLocation.Region)
+	assert.Equal(t, 3, sarifLocation.PhysicalLocation.Region.StartLine)
+	assert.Equal(t, 1, sarifLocation.PhysicalLocation.Region.StartColumn)
+	assert.Equal(t, "version", sarifLocation.LogicalLocations[0].FullyQualifiedName)
+}
+
+func TestJUnitReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	flags.Set("format", "junit")
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+
+	errorRuleConfiguration := ruleconfiguration.Type{
+		ProjectType:     projecttype.Sketch,
+		Category:        "structure",
+		Subcategory:     "general",
+		ID:              "XX001",
+		Brief:           "foo",
+		MessageTemplate: "bar {{.}}",
+		ErrorModes:      []rulemode.Type{rulemode.Default},
+	}
+	warningRuleConfiguration := errorRuleConfiguration
+	warningRuleConfiguration.ID = "XX002"
+	warningRuleConfiguration.ErrorModes = nil
+	warningRuleConfiguration.WarningModes = []rulemode.Type{rulemode.Default}
+
+	var results Type
+	results.Initialize()
+	results.Record(lintedProject, errorRuleConfiguration, ruleresult.Fail, "baz", []rulelocation.Type{{Path: "bar.ino", Line: 2}})
+	results.Record(lintedProject, warningRuleConfiguration, ruleresult.Fail, "qux", nil)
+	results.Record(lintedProject, errorRuleConfiguration, ruleresult.Pass, "", nil)
+	results.Record(lintedProject, errorRuleConfiguration, ruleresult.NotRun, "Unable to run", nil)
+	require.Len(t, results.Projects[0].Rules, 4, "All rules should be recorded for JUnit format")
+
+	var testSuites junitTestSuitesType
+	require.Nil(t, xml.Unmarshal([]byte(results.JUnitReport()), &testSuites))
+	assert.Equal(t, 4, testSuites.Tests)
+	assert.Equal(t, 1, testSuites.Failures)
+	assert.Equal(t, 1, testSuites.Skipped)
+	require.Len(t, testSuites.TestSuites, 1)
+	testSuite := testSuites.TestSuites[0]
+	assert.Equal(t, lintedProject.Path.String(), testSuite.Name)
+	require.Len(t, testSuite.TestCases, 4)
+
+	assert.Equal(t, "XX001: foo", testSuite.TestCases[0].Name)
+	assert.Equal(t, "sketch.structure.general", testSuite.TestCases[0].ClassName)
+	require.NotNil(t, testSuite.TestCases[0].Failure, "Error level failure")
+	assert.Equal(t, "bar baz", testSuite.TestCases[0].Failure.Message)
+	assert.Contains(t, testSuite.TestCases[0].Failure.Text, "at bar.ino:2")
+
+	assert.Nil(t, testSuite.TestCases[1].Failure, "Warning level failure")
+	require.NotNil(t, testSuite.TestCases[1].SystemOut)
+	assert.Equal(t, "WARNING: bar qux", testSuite.TestCases[1].SystemOut.Text)
+
+	assert.Nil(t, testSuite.TestCases[2].Failure, "Pass")
+	assert.Nil(t, testSuite.TestCases[2].Skipped)
+
+	require.NotNil(t, testSuite.TestCases[3].Skipped, "Not run")
+	assert.Equal(t, "Unable to run", testSuite.TestCases[3].Skipped.Message)
+}
+
+func TestGitHubActionsReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	flags.Set("format", "github-actions")
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	ruleConfiguration := ruleconfiguration.Type{
+		ProjectType:     projecttype.Library,
+		ID:              "XX001",
+		Brief:           "foo, bar",
+		MessageTemplate: "100% {{.}}",
+		WarningModes:    []rulemode.Type{rulemode.Default},
+	}
+
+	var results Type
+	results.Initialize()
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "baz\nqux", []rulelocation.Type{{Path: "library.properties", Line: 3, Column: 1, Key: "version"}})
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "baz", nil)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "", nil)
+
+	assert.Equal(
+		t,
+		"::warning file=library.properties,line=3,col=1,title=XX001%3A foo%2C bar::100%25 baz%0Aqux\n"+
+			"::warning file=.,title=XX001%3A foo%2C bar::100%25 baz\n",
+		results.GitHubActionsReport(),
+	)
+}
+
+func TestHelpURI(t *testing.T) {
+	assert.Equal(t, "https://arduino.github.io/arduino-cli/latest/library-specification/#library-root-folder", helpURI("Folder name {{.}} exceeds maximum length. See: https://arduino.github.io/arduino-cli/latest/library-specification/#library-root-folder"))
+	assert.Equal(t, "", helpURI("Folder name {{.}} exceeds maximum length."))
+}
+
+func TestBaseline(t *testing.T) {
+	baselineFolderPath, err := paths.MkTempDir("", "arduino-lint-result-baseline")
+	require.Nil(t, err)
+	defer baselineFolderPath.RemoveAll()
+	baselinePath := baselineFolderPath.Join("baseline.json")
+
+	lintedProject := project.Type{
+		Path:             baselineFolderPath.Join("foo"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+
+	flags := test.ConfigurationFlags()
+	flags.Set("write-baseline", baselinePath.String())
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	var results Type
+	results.Initialize()
+	require.Nil(t, results.LoadBaseline(), "No baseline to load")
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "bar", nil)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "bar", nil)
+	results.AddProjectSummary(lintedProject)
+	assert.Equal(t, 2, results.Projects[0].Summary.ErrorCount)
+	require.Nil(t, results.WriteBaseline())
+
+	var baseline baselineType
+	baselineData, err := baselinePath.ReadFile()
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(baselineData, &baseline))
+	assert.Equal(t, baselineVersion, baseline.Version)
+	require.Len(t, baseline.Findings, 2)
+	assert.Equal(t, "foo", baseline.Findings[0].Project, "Project path should be relative to baseline file")
+	assert.Equal(t, ruleConfiguration.ID, baseline.Findings[0].RuleID)
+
+	flags = test.ConfigurationFlags()
+	flags.Set("baseline", baselinePath.String())
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	results.Initialize()
+	require.Nil(t, results.LoadBaseline())
+	summaryText := results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "bar", nil)
+	assert.Contains(t, summaryText, "suppressed by baseline")
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "bar", nil)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "bar", nil)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "baz", nil)
+	results.AddProjectSummary(lintedProject)
+	require.Len(t, results.Projects[0].Rules, 4)
+	require.NotNil(t, results.Projects[0].Rules[0].Suppression)
+	assert.Equal(t, suppressionKindBaseline, results.Projects[0].Rules[0].Suppression.Kind)
+	require.NotNil(t, results.Projects[0].Rules[1].Suppression)
+	assert.Nil(t, results.Projects[0].Rules[2].Suppression, "Each baseline entry should only suppress one occurrence")
+	assert.Nil(t, results.Projects[0].Rules[3].Suppression, "New violation should not be suppressed")
+	assert.Equal(t, 2, results.Projects[0].Summary.SuppressedCount)
+	assert.Equal(t, 2, results.Projects[0].Summary.ErrorCount)
+
+	var sarifLog sarifLogType
+	require.Nil(t, json.Unmarshal([]byte(results.SARIFReport()), &sarifLog))
+	require.Len(t, sarifLog.Runs[0].Results[0].Suppressions, 1)
+	assert.Equal(t, "external", sarifLog.Runs[0].Results[0].Suppressions[0].Kind)
+	assert.Empty(t, sarifLog.Runs[0].Results[3].Suppressions)
+
+	require.Nil(t, baselinePath.WriteFile([]byte("{\"version\": 42}")))
+	assert.Error(t, results.LoadBaseline(), "Unsupported version")
+	require.Nil(t, baselinePath.Remove())
+	assert.Error(t, results.LoadBaseline(), "Missing baseline file")
+}
+
+func TestFingerprint(t *testing.T) {
+	ruleReport := ruleReportType{
+		ID:      "LS001",
+		Message: "Found /foo/bar/baz",
+	}
+	assert.Equal(t, fingerprint(paths.New("/foo/bar"), ruleReport), fingerprint(paths.New("/qux/bar"), ruleReportType{ID: "LS001", Message: "Found /qux/bar/baz"}), "Fingerprint should not depend on project location")
+	assert.NotEqual(t, fingerprint(paths.New("/foo/bar"), ruleReport), fingerprint(paths.New("/foo/bar"), ruleReportType{ID: "LS002", Message: "Found /foo/bar/baz"}))
+
+	locatedRuleReport := ruleReport
+	locatedRuleReport.Locations = []locationReportType{{Path: "library.properties", Line: 2, Key: "name"}}
+	movedRuleReport := ruleReport
+	movedRuleReport.Locations = []locationReportType{{Path: "library.properties", Line: 5, Key: "name"}}
+	assert.Equal(t, fingerprint(paths.New("/foo/bar"), locatedRuleReport), fingerprint(paths.New("/foo/bar"), movedRuleReport), "Fingerprint should not depend on line number")
+}
+
+func TestInSourceSuppression(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-result-suppression")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+
+	libraryProperties := []string{
+		"# arduino-lint-disable LP001, LP006",
+		"",
+		"# arduino-lint-disable LP002, LP003 -- Intentional",
+		"name=Foo",
+		"# Unrelated comment",
+		"version=1.0.0",
+		"# arduino-lint-disable-next-line LP004",
+		"author=Bar",
+	}
+	require.Nil(t, projectPath.Join("library.properties").WriteFile([]byte(strings.Join(libraryProperties, "\n"))))
+	source := []string{
+		"// arduino-lint-disable-next-line LC001",
+		"#include <arduino.h>",
+		"#include <arduino.h>",
+	}
+	require.Nil(t, projectPath.Join("Foo.h").WriteFile([]byte(strings.Join(source, "\n"))))
+
+	testTables := []struct {
+		ruleID                string
+		locations             []locationReportType
+		expectedJustification string
+	}{
+		{"LP001", []locationReportType{{Path: "library.properties", Line: 8, Key: "author"}}, "Disabled by comment at library.properties:1"},
+		{"LP001", []locationReportType{{Path: "library.properties", Key: "url"}}, "Disabled by comment at library.properties:1"},
+		{"lp003", []locationReportType{{Path: "library.properties", Line: 4, Key: "name"}}, "Intentional"},
+		{"LP003", []locationReportType{{Path: "library.properties", Line: 6, Key: "version"}}, ""},
+		{"LP004", []locationReportType{{Path: "library.properties", Line: 8, Key: "author"}}, ""},
+		{"LC001", []locationReportType{{Path: "Foo.h", Line: 2, Column: 1}}, "Disabled by comment at Foo.h:1"},
+		{"LC001", []locationReportType{{Path: "Foo.h", Line: 3, Column: 1}}, ""},
+		{"LC001", []locationReportType{{Path: "Foo.h", Line: 2}, {Path: "Foo.h", Line: 3}}, ""},
+		{"LS001", []locationReportType{{Path: "."}}, ""},
+		{"LS001", nil, ""},
+		{"LP006", nil, "Disabled by comment at library.properties:1"},
+		{"LP006", []locationReportType{{Path: "."}}, "Disabled by comment at library.properties:1"},
+		{"LP003", nil, ""},
+	}
+
+	lintedProject := project.Type{
+		Path:             projectPath,
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+	for _, testTable := range testTables {
+		suppression := inSourceSuppression(lintedProject, ruleReportType{ID: testTable.ruleID, Locations: testTable.locations})
+		if testTable.expectedJustification == "" {
+			assert.Nil(t, suppression, testTable.ruleID, testTable.locations)
+		} else if assert.NotNil(t, suppression, testTable.ruleID, testTable.locations) {
+			assert.Equal(t, suppressionKindInSource, suppression.Kind)
+			assert.Equal(t, testTable.expectedJustification, suppression.Justification)
+		}
+	}
+
+	sketchProject := lintedProject
+	sketchProject.ProjectType = projecttype.Sketch
+	assert.Nil(t, inSourceSuppression(sketchProject, ruleReportType{ID: "LP006"}), "Sketches have no metadata file for file scope comments")
+
+	flags := test.ConfigurationFlags()
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	ruleConfiguration.ID = "LP003"
+	var results Type
+	results.Initialize()
+	summaryText := results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "", []rulelocation.Type{{Path: "library.properties", Line: 4, Key: "name"}})
+	assert.Contains(t, summaryText, "(suppressed: Intentional)")
+	results.AddProjectSummary(lintedProject)
+	assert.Equal(t, 1, results.Projects[0].Summary.SuppressedCount)
+	assert.Zero(t, results.Projects[0].Summary.ErrorCount)
+	assert.Zero(t, results.Projects[0].Summary.WarningCount)
+
+	var sarifLog sarifLogType
+	require.Nil(t, json.Unmarshal([]byte(results.SARIFReport()), &sarifLog))
+	require.Len(t, sarifLog.Runs[0].Results[0].Suppressions, 1)
+	assert.Equal(t, sarifSuppressionType{Kind: "inSource", Justification: "Intentional"}, sarifLog.Runs[0].Results[0].Suppressions[0])
+
+	// Rules which report no location are suppressed by the file scope comments of the metadata file.
+	for _, ruleConfiguration = range ruleconfiguration.Configurations() {
+		if ruleConfiguration.ID == "LP006" {
+			break
+		}
+	}
+	require.Equal(t, "LP006", ruleConfiguration.ID)
+	results.Initialize()
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "", nil)
+	assert.Contains(t, summaryText, "(suppressed: Disabled by comment at library.properties:1)")
+}
+
+func TestFindings(t *testing.T) {
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+	flags := test.ConfigurationFlags()
+	flags.Set("verbose", "true")
+	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+
+	var results Type
+	results.Initialize()
+	assert.Empty(t, results.Findings(lintedProject), "No report for project")
+
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "", nil)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "baz", []rulelocation.Type{{Path: "bar.ino", Line: 2, Column: 3}})
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "qux", nil)
+	results.Projects[0].Rules[2].Suppression = &suppressionReportType{Kind: suppressionKindBaseline}
+
+	ruleLevel, err := rulelevel.RuleLevel(ruleConfiguration, ruleresult.Fail, lintedProject)
+	require.Nil(t, err)
+	assert.Equal(
+		t,
+		[]string{fmt.Sprintf("%s %s bar.ino:2:3: %s", ruleLevel, ruleConfiguration.ID, message(ruleConfiguration.MessageTemplate, "baz"))},
+		results.Findings(lintedProject),
+		"Only unsuppressed failures are findings",
+	)
+}
@@ -0,0 +1,333 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// Generation of reports in the SARIF format.
+// See: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/go-paths-helper"
+)
+
+// sarifLogType is the type for the top level SARIF log object.
+type sarifLogType struct {
+	Schema  string         `json:"$schema"`
+	Version string         `json:"version"`
+	Runs    []sarifRunType `json:"runs"`
+}
+
+// sarifRunType is the type for a single run of the tool.
+type sarifRunType struct {
+	Tool    sarifToolType     `json:"tool"`
+	Results []sarifResultType `json:"results"`
+}
+
+// sarifToolType is the type for the description of the tool.
+type sarifToolType struct {
+	Driver sarifToolComponentType `json:"driver"`
+}
+
+// sarifToolComponentType is the type for the description of the tool's driver component.
+type sarifToolComponentType struct {
+	Name            string                         `json:"name"`
+	SemanticVersion string                         `json:"semanticVersion,omitempty"`
+	InformationURI  string                         `json:"informationUri"`
+	Rules           []sarifReportingDescriptorType `json:"rules"`
+}
+
+// sarifReportingDescriptorType is the type for the metadata of a rule.
+type sarifReportingDescriptorType struct {
+	ID                   string                  `json:"id"`
+	ShortDescription     sarifMessageType        `json:"shortDescription"`
+	FullDescription      *sarifMessageType       `json:"fullDescription,omitempty"`
+	HelpURI              string                  `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfigurationType  `json:"defaultConfiguration"`
+	Properties           sarifRulePropertiesType `json:"properties"`
+}
+
+// sarifConfigurationType is the type for the default configuration of a rule.
+type sarifConfigurationType struct {
+	Level string `json:"level"`
+}
+
+// sarifRulePropertiesType is the type for the Arduino Lint-specific rule metadata.
+type sarifRulePropertiesType struct {
+	Category    string `json:"category"`
+	Subcategory string `json:"subcategory"`
+}
+
+// sarifMessageType is the type for SARIF message strings.
+type sarifMessageType struct {
+	Text string `json:"text"`
+}
+
+// sarifResultType is the type for a rule failure.
+type sarifResultType struct {
+	RuleID       string                    `json:"ruleId"`
+	RuleIndex    int                       `json:"ruleIndex"`
+	Level        string                    `json:"level"`
+	Message      sarifMessageType          `json:"message"`
+	Locations    []sarifLocationType       `json:"locations"`
+	Suppressions []sarifSuppressionType    `json:"suppressions,omitempty"`
+	Properties   sarifResultPropertiesType `json:"properties"`
+}
+
+// sarifSuppressionType is the type for the suppression of a rule failure.
+type sarifSuppressionType struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// sarifResultPropertiesType is the type for the Arduino Lint-specific result metadata.
+type sarifResultPropertiesType struct {
+	ProjectType string `json:"projectType"`
+}
+
+// sarifLocationType is the type for the location of a rule failure.
+type sarifLocationType struct {
+	PhysicalLocation sarifPhysicalLocationType  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocationType `json:"logicalLocations,omitempty"`
+}
+
+// sarifPhysicalLocationType is the type for the location of a rule failure in the file system.
+type sarifPhysicalLocationType struct {
+	ArtifactLocation sarifArtifactLocationType `json:"artifactLocation"`
+	Region           *sarifRegionType          `json:"region,omitempty"`
+}
+
+// sarifRegionType is the type for the region of a file a rule failure occurred in.
+type sarifRegionType struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// sarifLogicalLocationType is the type for the property a rule failure occurred in.
+type sarifLogicalLocationType struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// sarifArtifactLocationType is the type for the file or folder a rule failure occurred in.
+type sarifArtifactLocationType struct {
+	URI string `json:"uri"`
+}
+
+// SARIFReport returns a SARIF formatted report of rules on all projects in string encoding.
+func (results Type) SARIFReport() string {
+	return string(results.sarifReportRaw())
+}
+
+// sarifReportRaw returns the report marshalled into SARIF format in byte encoding.
+func (results Type) sarifReportRaw() []byte {
+	sarifLog := sarifLogType{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRunType{
+			{
+				Tool: sarifToolType{
+					Driver: sarifToolComponentType{
+						Name:            "arduino-lint",
+						SemanticVersion: configuration.Version(),
+						InformationURI:  "https://github.com/arduino/arduino-lint",
+						Rules:           []sarifReportingDescriptorType{},
+					},
+				},
+				Results: []sarifResultType{},
+			},
+		},
+	}
+
+	ruleIndexes := make(map[string]int)
+	for index, ruleConfiguration := range ruleconfiguration.Configurations() {
+		ruleIndexes[ruleConfiguration.ID] = index
+		sarifLog.Runs[0].Tool.Driver.Rules = append(sarifLog.Runs[0].Tool.Driver.Rules, sarifReportingDescriptor(ruleConfiguration))
+	}
+
+	for _, projectReport := range results.Projects {
+		for _, ruleReport := range projectReport.Rules {
+			if ruleReport.Result != ruleresult.Fail.String() {
+				continue
+			}
+
+			sarifResult := sarifResultType{
+				RuleID:     ruleReport.ID,
+				RuleIndex:  ruleIndexes[ruleReport.ID],
+				Level:      sarifLevel(ruleReport.Level),
+				Message:    sarifMessageType{Text: ruleReport.Message},
+				Locations:  sarifLocations(projectReport, ruleReport.Locations),
+				Properties: sarifResultPropertiesType{ProjectType: projectReport.ProjectType},
+			}
+			if ruleReport.Suppression != nil {
+				sarifResult.Suppressions = []sarifSuppressionType{sarifSuppression(*ruleReport.Suppression)}
+			}
+
+			sarifLog.Runs[0].Results = append(sarifLog.Runs[0].Results, sarifResult)
+		}
+	}
+
+	var marshalledReportBuffer bytes.Buffer
+	jsonEncoder := json.NewEncoder(io.Writer(&marshalledReportBuffer))
+	jsonEncoder.SetEscapeHTML(false)
+	jsonEncoder.SetIndent("", "  ")
+	err := jsonEncoder.Encode(sarifLog)
+	if err != nil {
+		panic(fmt.Sprintf("Error while formatting SARIF report: %v", err))
+	}
+
+	return marshalledReportBuffer.Bytes()
+}
+
+// sarifReportingDescriptor returns the SARIF rule metadata object for the given rule configuration.
+func sarifReportingDescriptor(ruleConfiguration ruleconfiguration.Type) sarifReportingDescriptorType {
+	reportingDescriptor := sarifReportingDescriptorType{
+		ID:               ruleConfiguration.ID,
+		ShortDescription: sarifMessageType{Text: ruleConfiguration.Brief},
+		HelpURI:          helpURI(ruleConfiguration.MessageTemplate),
+		DefaultConfiguration: sarifConfigurationType{
+			Level: "warning",
+		},
+		Properties: sarifRulePropertiesType{
+			Category:    ruleConfiguration.Category,
+			Subcategory: ruleConfiguration.Subcategory,
+		},
+	}
+
+	if ruleConfiguration.Description != "" {
+		reportingDescriptor.FullDescription = &sarifMessageType{Text: ruleConfiguration.Description}
+	}
+
+	if ruleLevel, err := rulelevel.FailRuleLevel(ruleConfiguration, configuration.RuleModes(ruleConfiguration.ProjectType)); err == nil {
+		reportingDescriptor.DefaultConfiguration.Level = sarifLevel(ruleLevel.String())
+	}
+
+	return reportingDescriptor
+}
+
+// sarifLocations returns the SARIF locations of a rule failure in the given project.
+// If the rule did not provide locations, the project path is used.
+func sarifLocations(projectReport projectReportType, locationReports []locationReportType) []sarifLocationType {
+	if len(locationReports) == 0 {
+		return []sarifLocationType{
+			{
+				PhysicalLocation: sarifPhysicalLocationType{
+					ArtifactLocation: sarifArtifactLocationType{URI: sarifURI(projectReport.Path)},
+				},
+			},
+		}
+	}
+
+	locations := []sarifLocationType{}
+	for _, locationReport := range locationReports {
+		location := sarifLocationType{
+			PhysicalLocation: sarifPhysicalLocationType{
+				ArtifactLocation: sarifArtifactLocationType{URI: sarifURI(projectReport.locationPath(locationReport.Path))},
+			},
+		}
+		if locationReport.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegionType{
+				StartLine:   locationReport.Line,
+				StartColumn: locationReport.Column,
+			}
+		}
+		if locationReport.Key != "" {
+			location.LogicalLocations = []sarifLogicalLocationType{
+				{
+					FullyQualifiedName: locationReport.Key,
+					Kind:               "member",
+				},
+			}
+		}
+
+		locations = append(locations, location)
+	}
+
+	return locations
+}
+
+// sarifSuppression returns the SARIF suppression object for the given rule violation suppression.
+func sarifSuppression(suppressionReport suppressionReportType) sarifSuppressionType {
+	suppression := sarifSuppressionType{
+		Kind:          "external",
+		Justification: suppressionReport.Justification,
+	}
+	if suppressionReport.Kind == suppressionKindInSource {
+		suppression.Kind = "inSource"
+	}
+	if suppressionReport.Kind == suppressionKindBaseline && suppression.Justification == "" {
+		suppression.Justification = "Recorded in baseline file"
+	}
+
+	return suppression
+}
+
+// helpURI returns the documentation URL referenced by the rule's message template, if any.
+func helpURI(messageTemplate string) string {
+	helpURIRegexp := regexp.MustCompile(`See:\s+(https?://[^\s"'<>]+)`)
+	submatches := helpURIRegexp.FindStringSubmatch(messageTemplate)
+	if submatches == nil {
+		return ""
+	}
+
+	return strings.TrimRight(submatches[1], ".,;)")
+}
+
+// sarifLevel returns the SARIF result level corresponding to the given rule level string.
+func sarifLevel(ruleLevel string) string {
+	switch ruleLevel {
+	case rulelevel.Error.String():
+		return "error"
+	case rulelevel.Warning.String():
+		return "warning"
+	case rulelevel.Info.String():
+		return "note"
+	default:
+		return "none"
+	}
+}
+
+// sarifURI returns the URI reference for the given path.
+// Paths under the working directory are made relative so that code scanning services can resolve them against the repository root.
+func sarifURI(path *paths.Path) string {
+	workingDirectoryPath, err := paths.Getwd()
+	if err == nil {
+		if relativePath, err := path.RelFrom(workingDirectoryPath); err == nil && !strings.HasPrefix(relativePath.String(), "..") {
+			return (&url.URL{Path: filepath.ToSlash(relativePath.String())}).String()
+		}
+	}
+
+	absolutePath, err := path.Abs()
+	if err != nil {
+		absolutePath = path
+	}
+	uriPath := filepath.ToSlash(absolutePath.String())
+	if !strings.HasPrefix(uriPath, "/") {
+		uriPath = "/" + uriPath // Windows drive letter paths.
+	}
+	return (&url.URL{Scheme: "file", Path: uriPath}).String()
+}
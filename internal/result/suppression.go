@@ -0,0 +1,211 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// Support for comments in the project files which suppress violations of specific rules.
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/project/sketch"
+	"github.com/arduino/go-paths-helper"
+)
+
+// suppressionCommentRegexp matches the text of a suppression comment, capturing the directive, the rule IDs, and the
+// optional justification.
+var suppressionCommentRegexp = regexp.MustCompile(`^arduino-lint-(disable(?:-next-line)?)\s+(.*?)(?:\s+--\s*(.*?))?\s*$`)
+
+// metadataFileNames maps the project types to the names of the metadata files whose file scope suppression comments
+// apply to the rule violations which have no location.
+var metadataFileNames = map[projecttype.Type]string{
+	projecttype.Library:  "library.properties",
+	projecttype.Platform: "platform.txt",
+}
+
+// suppressionCommentType is the type for the suppression comments found in the project files.
+type suppressionCommentType struct {
+	line          int // 1-based line number of the comment.
+	ruleIDs       []string
+	justification string
+}
+
+// suppresses returns whether the comment suppresses violations of the given rule.
+func (suppressionComment suppressionCommentType) suppresses(ruleID string) bool {
+	for _, suppressedRuleID := range suppressionComment.ruleIDs {
+		if strings.EqualFold(suppressedRuleID, ruleID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inSourceSuppression returns the suppression of the given rule violation by comments in the project files, or nil if
+// the violation is not suppressed. A violation is only suppressed if all its locations are. A violation without
+// locations, or located at a folder, is suppressed by the file scope comments of the project's metadata file.
+func inSourceSuppression(lintedProject project.Type, ruleReport ruleReportType) *suppressionReportType {
+	basePath := lintedProject.Path
+	if basePath.IsNotDir() {
+		// Package index projects are files.
+		basePath = basePath.Parent()
+	}
+
+	locations := ruleReport.Locations
+	if len(locations) == 0 {
+		locations = []locationReportType{{Path: "."}}
+	}
+
+	var suppression *suppressionReportType
+	for _, locationReport := range locations {
+		commentPath := locationReport.Path
+		line := locationReport.Line
+		if basePath.Join(commentPath).IsDir() {
+			metadataFileName, ok := metadataFileNames[lintedProject.ProjectType]
+			if !ok {
+				return nil
+			}
+			// There is no line to match, so only the file scope comments apply.
+			commentPath = metadataFileName
+			line = 0
+		}
+
+		suppressionComment, found := findSuppressionComment(basePath.Join(commentPath), ruleReport.ID, line)
+		if !found {
+			return nil
+		}
+
+		if suppression == nil {
+			suppression = &suppressionReportType{
+				Kind:          suppressionKindInSource,
+				Justification: suppressionComment.justification,
+			}
+			if suppression.Justification == "" {
+				suppression.Justification = fmt.Sprintf("Disabled by comment at %s:%d", commentPath, suppressionComment.line)
+			}
+		}
+	}
+
+	return suppression
+}
+
+// findSuppressionComment returns the comment in the given file which suppresses violations of the rule at the given
+// line, if any.
+func findSuppressionComment(filePath *paths.Path, ruleID string, line int) (suppressionCommentType, bool) {
+	if filePath.IsDir() {
+		return suppressionCommentType{}, false
+	}
+
+	lines, err := filePath.ReadFileAsLines()
+	if err != nil {
+		return suppressionCommentType{}, false
+	}
+
+	switch {
+	case isPropertiesFile(filePath):
+		return findPropertiesSuppressionComment(lines, ruleID, line)
+	case sketch.HasSupportedExtension(filePath):
+		return findSourceSuppressionComment(lines, ruleID, line)
+	default:
+		return suppressionCommentType{}, false
+	}
+}
+
+// isPropertiesFile returns whether the file is in the properties format used by library.properties and the platform
+// configuration files.
+func isPropertiesFile(filePath *paths.Path) bool {
+	return filePath.Ext() == ".properties" || filePath.Ext() == ".txt"
+}
+
+// findPropertiesSuppressionComment returns the comment in the properties file lines which suppresses violations of the
+// rule at the given line, if any.
+// A `# arduino-lint-disable` comment directly above a property applies to that property. A comment which is not
+// directly followed by a property (e.g., separated from it by an empty line) applies to the whole file.
+func findPropertiesSuppressionComment(lines []string, ruleID string, line int) (suppressionCommentType, bool) {
+	fileSuppressionComments := []suppressionCommentType{}
+	pendingSuppressionComments := []suppressionCommentType{}
+	for lineIndex, lineText := range lines {
+		trimmedLine := strings.TrimSpace(lineText)
+		switch {
+		case trimmedLine == "":
+			fileSuppressionComments = append(fileSuppressionComments, pendingSuppressionComments...)
+			pendingSuppressionComments = nil
+		case strings.HasPrefix(trimmedLine, "#"):
+			suppressionComment, directive, ok := parseSuppressionComment(strings.TrimPrefix(trimmedLine, "#"), lineIndex+1)
+			if ok && directive == "disable" {
+				pendingSuppressionComments = append(pendingSuppressionComments, suppressionComment)
+			}
+		default:
+			if lineIndex+1 == line {
+				for _, suppressionComment := range pendingSuppressionComments {
+					if suppressionComment.suppresses(ruleID) {
+						return suppressionComment, true
+					}
+				}
+			}
+			pendingSuppressionComments = nil
+		}
+	}
+	fileSuppressionComments = append(fileSuppressionComments, pendingSuppressionComments...)
+
+	for _, suppressionComment := range fileSuppressionComments {
+		if suppressionComment.suppresses(ruleID) {
+			return suppressionComment, true
+		}
+	}
+
+	return suppressionCommentType{}, false
+}
+
+// findSourceSuppressionComment returns the `// arduino-lint-disable-next-line` comment in the source file lines which
+// suppresses violations of the rule at the given line, if any.
+func findSourceSuppressionComment(lines []string, ruleID string, line int) (suppressionCommentType, bool) {
+	if line < 2 || line > len(lines) {
+		return suppressionCommentType{}, false
+	}
+
+	previousLine := strings.TrimSpace(lines[line-2])
+	if !strings.HasPrefix(previousLine, "//") {
+		return suppressionCommentType{}, false
+	}
+
+	suppressionComment, directive, ok := parseSuppressionComment(strings.TrimPrefix(previousLine, "//"), line-1)
+	if !ok || directive != "disable-next-line" || !suppressionComment.suppresses(ruleID) {
+		return suppressionCommentType{}, false
+	}
+
+	return suppressionComment, true
+}
+
+// parseSuppressionComment parses the text of a comment, returning the suppression and its directive if it is a
+// suppression comment.
+func parseSuppressionComment(commentText string, line int) (suppressionCommentType, string, bool) {
+	submatches := suppressionCommentRegexp.FindStringSubmatch(strings.TrimSpace(commentText))
+	if submatches == nil {
+		return suppressionCommentType{}, "", false
+	}
+
+	suppressionComment := suppressionCommentType{
+		line:          line,
+		ruleIDs:       regexp.MustCompile(`[\s,]+`).Split(strings.Trim(submatches[2], " \t,"), -1),
+		justification: submatches[3],
+	}
+
+	return suppressionComment, submatches[1], true
+}
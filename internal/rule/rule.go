@@ -17,25 +17,89 @@
 package rule
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/result"
 	"github.com/arduino/arduino-lint/internal/result/feedback"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/sirupsen/logrus"
 )
 
-// Runner runs all rules for the given project and outputs the results.
-func Runner(project project.Type) {
-	feedback.Printf("Linting %s in %s\n", project.ProjectType, project.Path)
+func init() {
+	ruleIDs := []string{}
+	ruleCategories := []string{}
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		ruleIDs = append(ruleIDs, ruleConfiguration.ID)
+		ruleCategories = append(ruleCategories, ruleConfiguration.Category, ruleConfiguration.Subcategory)
+	}
+	configuration.SetRuleIDs(ruleIDs)
+	configuration.SetRuleCategories(ruleCategories)
+}
+
+// ResultType is the type for the result of running a rule on a project.
+type ResultType struct {
+	Configuration ruleconfiguration.Type
+	Result        ruleresult.Type
+	Output        string
+	Locations     []rulelocation.Type
+}
+
+// RunProjects starts running the rules on the given projects, using the configured number of concurrent jobs.
+// The rule results of each project are sent on the channel at the project's index in the returned slice.
+// Once the context is canceled, projects that have not yet started running are skipped and nil results are sent for them.
+func RunProjects(ctx context.Context, projects []project.Type) ([]chan []ResultType, error) {
+	for _, project := range projects {
+		if project.ProjectType == projecttype.Library {
+			// Load in advance so that an error can be returned.
+			if err := projectdata.LoadLibraryManagerIndex(); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	ruleResultsChannels := make([]chan []ResultType, len(projects))
+	for index := range projects {
+		ruleResultsChannels[index] = make(chan []ResultType, 1)
+	}
 
-	projectdata.Initialize(project)
+	projectIndexes := make(chan int)
+	for job := 0; job < configuration.Jobs(); job++ {
+		go func() {
+			for index := range projectIndexes {
+				if ctx.Err() != nil {
+					ruleResultsChannels[index] <- nil
+					continue
+				}
+				ruleResultsChannels[index] <- Run(projects[index])
+			}
+		}()
+	}
 
+	go func() {
+		for index := range projects {
+			projectIndexes <- index
+		}
+		close(projectIndexes)
+	}()
+
+	return ruleResultsChannels, nil
+}
+
+// Run runs all rules for the given project and returns their results.
+// Only data of the given project is modified, so multiple projects can be run concurrently.
+func Run(project project.Type) []ResultType {
+	projectData := projectdata.Initialize(project)
+
+	var ruleResults []ResultType
 	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
 		runRule, err := shouldRun(ruleConfiguration, project)
 		if err != nil {
@@ -47,12 +111,32 @@ func Runner(project project.Type) {
 			continue
 		}
 
+		ruleResult, ruleOutput := ruleConfiguration.RuleFunction(projectData)
+		ruleResults = append(
+			ruleResults,
+			ResultType{
+				Configuration: ruleConfiguration,
+				Result:        ruleResult,
+				Output:        ruleOutput,
+				Locations:     projectData.Locations(),
+			},
+		)
+	}
+
+	return ruleResults
+}
+
+// Record records the results of the rules run on the given project and outputs them.
+// In order for the report to be deterministic, projects must be recorded in the same order regardless of the order they finished running in.
+func Record(project project.Type, ruleResults []ResultType) {
+	feedback.Printf("Linting %s in %s\n", project.ProjectType, configuration.ReportPath(project.Path))
+
+	for _, ruleResult := range ruleResults {
 		// Output will be printed after all rules are finished when configured for "json" output format.
-		feedback.VerbosePrintf("Running rule %s (%s)...\n", ruleConfiguration.ID, ruleConfiguration.Brief)
+		feedback.VerbosePrintf("Running rule %s (%s)...\n", ruleResult.Configuration.ID, ruleResult.Configuration.Brief)
 
-		ruleResult, ruleOutput := ruleConfiguration.RuleFunction()
-		reportText := result.Results.Record(project, ruleConfiguration, ruleResult, ruleOutput)
-		if (ruleResult == ruleresult.Fail) || configuration.Verbose() {
+		reportText := result.Results.Record(project, ruleResult.Configuration, ruleResult.Result, ruleResult.Output, ruleResult.Locations)
+		if (ruleResult.Result == ruleresult.Fail) || configuration.Verbose() {
 			feedback.Println(reportText)
 		}
 	}
@@ -66,11 +150,19 @@ func shouldRun(ruleConfiguration ruleconfiguration.Type, currentProject project.
 		return false, nil
 	}
 
+	if !configuration.RuleSelected(ruleConfiguration.ID, ruleConfiguration.Category, ruleConfiguration.Subcategory) {
+		return false, nil
+	}
+
 	return IsEnabled(ruleConfiguration, configurationRuleModes)
 }
 
 // IsEnabled returns whether a given rule is enabled under a given tool configuration.
 func IsEnabled(ruleConfiguration ruleconfiguration.Type, configurationRuleModes map[rulemode.Type]bool) (bool, error) {
+	if configuration.RuleDisabled(ruleConfiguration.ID) {
+		return false, nil
+	}
+
 	for _, disableMode := range ruleConfiguration.DisableModes {
 		if configurationRuleModes[disableMode] {
 			return false, nil
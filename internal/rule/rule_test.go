@@ -16,6 +16,8 @@
 package rule
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
@@ -24,7 +26,9 @@ import (
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
 	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_shouldRun(t *testing.T) {
@@ -58,7 +62,7 @@ func Test_shouldRun(t *testing.T) {
 		flags.Set("library-manager", testTable.libraryManagerSetting)
 		flags.Set("compliance", testTable.complianceSetting)
 
-		configuration.Initialize(flags, []string{"/foo"})
+		configuration.Initialize(flags, []string{"."})
 
 		ruleConfiguration := ruleconfiguration.Type{
 			ProjectType:      testTable.ruleProjectType,
@@ -78,3 +82,106 @@ func Test_shouldRun(t *testing.T) {
 		}
 	}
 }
+
+func TestIsEnabledConfigurationFile(t *testing.T) {
+	ruleConfiguration := ruleconfiguration.Type{
+		ID:          "LS001",
+		EnableModes: []rulemode.Type{rulemode.Default},
+	}
+
+	flags := test.ConfigurationFlags()
+	configuration.Initialize(flags, []string{"."})
+	enabled, err := IsEnabled(ruleConfiguration, configuration.RuleModes(projecttype.Library))
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	flags.Set("config", "testdata/arduino-lint.yml")
+	configuration.Initialize(flags, []string{"."})
+	enabled, err = IsEnabled(ruleConfiguration, configuration.RuleModes(projecttype.Library))
+	assert.NoError(t, err)
+	assert.False(t, enabled, "Rule disabled by configuration file")
+}
+
+func Test_shouldRunRuleSelection(t *testing.T) {
+	ruleConfiguration := ruleconfiguration.Type{
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.All,
+		Category:         "structure",
+		Subcategory:      "miscellaneous",
+		ID:               "SS001",
+		EnableModes:      []rulemode.Type{rulemode.Default},
+	}
+	project := project.Type{
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+
+	testTables := []struct {
+		flagName           string
+		flagValue          string
+		shouldRunAssertion assert.BoolAssertionFunc
+	}{
+		{"rules", "SS001", assert.True},
+		{"rules", "SC001", assert.False},
+		{"skip-rules", "SS*", assert.False},
+		{"category", "code,structure", assert.True},
+		{"category", "code", assert.False},
+	}
+
+	for _, testTable := range testTables {
+		flags := test.ConfigurationFlags()
+		flags.Set(testTable.flagName, testTable.flagValue)
+		require.Nil(t, configuration.Initialize(flags, []string{"."}))
+		runRule, err := shouldRun(ruleConfiguration, project)
+		assert.NoError(t, err)
+		testTable.shouldRunAssertion(t, runRule, fmt.Sprintf("--%s %s", testTable.flagName, testTable.flagValue))
+	}
+}
+
+func TestRunConcurrently(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration.Initialize(flags, []string{"."})
+
+	var projects []project.Type
+	for _, sketchName := range []string{"Foo", "Bar", "Baz"} {
+		projects = append(
+			projects,
+			project.Type{
+				Path:             paths.New("testdata", "sketches", sketchName),
+				ProjectType:      projecttype.Sketch,
+				SuperprojectType: projecttype.Sketch,
+			},
+		)
+	}
+
+	sequentialRuleResults := make([][]ResultType, len(projects))
+	for index := range projects {
+		sequentialRuleResults[index] = Run(projects[index])
+	}
+
+	concurrentRuleResults := make([][]ResultType, len(projects))
+	var waitGroup sync.WaitGroup
+	for index := range projects {
+		waitGroup.Add(1)
+		go func(index int) {
+			defer waitGroup.Done()
+			concurrentRuleResults[index] = Run(projects[index])
+		}(index)
+	}
+	waitGroup.Wait()
+
+	for index := range projects {
+		assert.Equal(t, ruleResultsSummary(sequentialRuleResults[index]), ruleResultsSummary(concurrentRuleResults[index]), "Results don't depend on other projects being run concurrently")
+	}
+	assert.NotEqual(t, ruleResultsSummary(concurrentRuleResults[0]), ruleResultsSummary(concurrentRuleResults[1]), "Each project has its own results")
+}
+
+// ruleResultsSummary returns a comparable representation of the given rule results.
+func ruleResultsSummary(ruleResults []ResultType) []string {
+	var summary []string
+	for _, ruleResult := range ruleResults {
+		summary = append(summary, fmt.Sprintf("%s %s %s %v", ruleResult.Configuration.ID, ruleResult.Result, ruleResult.Output, ruleResult.Locations))
+	}
+
+	return summary
+}
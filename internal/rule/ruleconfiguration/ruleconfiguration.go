@@ -2809,6 +2809,70 @@ var configurations = []Type{
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
 		RuleFunction:     rulefunction.IncorrectArduinoDotHFileNameCase,
 	},
+	{
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.All,
+		Category:         "package index",
+		Subcategory:      "release",
+		ID:               "PI001",
+		Brief:            "release missing",
+		Description:      "The platform.txt name and version must match a platform release in the package index specified by the --package-index flag.",
+		MessageTemplate:  "No release of {{.}} in the package index. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/#platforms-definitions",
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PlatformPackageIndexReleaseMissing,
+	},
+	{
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.All,
+		Category:         "package index",
+		Subcategory:      "release",
+		ID:               "PI002",
+		Brief:            "boards missing",
+		Description:      "The boards list of the package index release is shown to the user in Boards Manager.",
+		MessageTemplate:  "Board(s) missing from the boards list of the package index release: {{.}}. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/#platforms-definitions",
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PlatformPackageIndexBoardsMissing,
+	},
+	{
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.All,
+		Category:         "package index",
+		Subcategory:      "release",
+		ID:               "PI003",
+		Brief:            "unknown boards",
+		Description:      "The boards list of the package index release is shown to the user in Boards Manager.",
+		MessageTemplate:  "Board(s) in the boards list of the package index release not found in boards.txt: {{.}}. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/#platforms-definitions",
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PlatformPackageIndexBoardsUnknown,
+	},
+	{
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.All,
+		Category:         "package index",
+		Subcategory:      "release",
+		ID:               "PI004",
+		Brief:            "tool dependencies missing",
+		Description:      "Only the tools in the toolsDependencies of the package index release are installed with the platform.",
+		MessageTemplate:  "Tool(s) used by the platform missing from the toolsDependencies of the package index release: {{.}}. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/#platforms-definitions",
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PlatformPackageIndexToolsDependenciesMissing,
+	},
 	{
 		ProjectType:      projecttype.PackageIndex,
 		SuperprojectType: projecttype.All,
@@ -2857,4 +2921,308 @@ var configurations = []Type{
 		ErrorModes:       []rulemode.Type{rulemode.Default},
 		RuleFunction:     rulefunction.PackageIndexFormat,
 	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "package",
+		ID:               "ID003",
+		Brief:            "maintainer missing",
+		Description:      "",
+		MessageTemplate:  `Missing required "maintainer" property for package(s): {{.}}. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexPackagesMaintainerMissing,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "package",
+		ID:               "ID004",
+		Brief:            "maintainer < min length",
+		Description:      "",
+		MessageTemplate:  `Value of "maintainer" property is less than the minimum length for package(s): {{.}}`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexPackagesMaintainerLTMinLength,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "package",
+		ID:               "ID005",
+		Brief:            "websiteURL missing",
+		Description:      "",
+		MessageTemplate:  `Missing "websiteURL" property for package(s): {{.}}. This link is shown in the Boards Manager. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PackageIndexPackagesWebsiteURLMissing,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "package",
+		ID:               "ID006",
+		Brief:            "help.online missing",
+		Description:      "",
+		MessageTemplate:  `Missing "help.online" property for package(s): {{.}}. This is the link to the support resource shown in the Boards Manager. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PackageIndexPackagesHelpOnlineMissing,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "platform",
+		ID:               "ID007",
+		Brief:            "architecture missing",
+		Description:      "",
+		MessageTemplate:  `Missing required "architecture" property for platform(s): {{.}}. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchitectureMissing,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "platform",
+		ID:               "ID008",
+		Brief:            "invalid architecture",
+		Description:      "Allowed characters: letters, numbers, _, -, and .",
+		MessageTemplate:  `Invalid "architecture" property value for platform(s): {{.}}. The architecture is used as the platform folder name, so it may only contain letters, numbers, _, -, and .`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchitectureInvalid,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "platform",
+		ID:               "ID009",
+		Brief:            "category missing",
+		Description:      "",
+		MessageTemplate:  `Missing required "category" property for platform(s): {{.}}. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsCategoryMissing,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "platform",
+		ID:               "ID010",
+		Brief:            "invalid category",
+		Description:      "",
+		MessageTemplate:  `Invalid "category" property value for platform(s): {{.}}. 3rd party platforms should use the "Contributed" category. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsCategoryInvalid,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "platform",
+		ID:               "ID011",
+		Brief:            "no boards",
+		Description:      "",
+		MessageTemplate:  `No boards listed for platform(s): {{.}}. The "boards" list is shown to users in the Boards Manager. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsBoardsEmpty,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "archive",
+		ID:               "ID012",
+		Brief:            "invalid checksum format",
+		Description:      "",
+		MessageTemplate:  `Invalid "checksum" property format for release(s): {{.}}. The format is ALGORITHM:DIGEST, where ALGORITHM is SHA-256, SHA-1, or MD5. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexChecksumInvalidFormat,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "archive",
+		ID:               "ID013",
+		Brief:            "checksum mismatch",
+		Description:      "Only checked for the archives found in the folder specified by the `--archives-dir` flag.",
+		MessageTemplate:  `The "checksum" property does not match the archive file for release(s): {{.}}. Installation of these releases will fail.`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexChecksumMismatch,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "archive",
+		ID:               "ID014",
+		Brief:            "size mismatch",
+		Description:      "Only checked for the archives found in the folder specified by the `--archives-dir` flag.",
+		MessageTemplate:  `The "size" property does not match the archive file for release(s): {{.}}`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexSizeMismatch,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "platform",
+		ID:               "ID015",
+		Brief:            "unresolved tool dependency",
+		Description:      "Dependencies on the tools of packages not in the package index are not checked, since those are provided by other package indexes.",
+		MessageTemplate:  `Tool dependencies not provided by the package index: {{.}}. Installation of these platforms will fail. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexToolsDependenciesUnresolved,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "tool",
+		ID:               "ID016",
+		Brief:            "missing tool systems",
+		Description:      "The standard hosts are i686-linux-gnu, x86_64-linux-gnu, arm-linux-gnueabihf, aarch64-linux-gnu, i686-mingw32, and x86_64-apple-darwin. A system with host all provides the tool for every host.",
+		MessageTemplate:  `Tool release(s) with no system for standard host(s): {{.}}. Platforms depending on these tools can't be installed on those hosts.`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PackageIndexToolsSystemsMissing,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "release",
+		ID:               "ID017",
+		Brief:            "duplicate version",
+		Description:      "",
+		MessageTemplate:  `Multiple releases with the same version: {{.}}. Add new releases with a new version.`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexReleaseVersionDuplicate,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "release",
+		ID:               "ID018",
+		Brief:            "non-semver version",
+		Description:      "",
+		MessageTemplate:  `Release version(s) not compliant with the semver specification: {{.}}. See https://semver.org/`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PackageIndexReleaseVersionNonSemver,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "release",
+		ID:               "ID019",
+		Brief:            "version order",
+		Description:      "",
+		MessageTemplate:  `Release(s) out of the version order of the other releases: {{.}}. Releases are normally listed consistently from oldest to newest or from newest to oldest.`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PackageIndexReleaseVersionOrder,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "platform",
+		ID:               "ID020",
+		Brief:            "name or category changed",
+		Description:      "",
+		MessageTemplate:  `Platform release(s) with a different "name" or "category" than the previous release: {{.}}`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     []rulemode.Type{rulemode.Default},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		RuleFunction:     rulefunction.PackageIndexPlatformNameCategoryChanged,
+	},
+	{
+		ProjectType:      projecttype.PackageIndex,
+		SuperprojectType: projecttype.All,
+		Category:         "data",
+		Subcategory:      "archive",
+		ID:               "ID021",
+		Brief:            "archive file name collision",
+		Description:      "Systems of a tool release that share the same archive are not collisions.",
+		MessageTemplate:  `Different release archives with the same "archiveFileName": {{.}}. Boards Manager may install the wrong archive from its download cache.`,
+		DisableModes:     nil,
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        nil,
+		WarningModes:     nil,
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+		RuleFunction:     rulefunction.PackageIndexArchiveFileNameCollision,
+	},
 }
@@ -0,0 +1,283 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package ruledocumentation
+
+// The output formats of the rule documentation.
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+)
+
+// The supported output formats.
+const (
+	Text     = "text"
+	JSON     = "json"
+	Markdown = "markdown"
+)
+
+// ruleSummaryType is the type for the JSON format rule list entries.
+type ruleSummaryType struct {
+	ID               string `json:"ID"`
+	ProjectType      string `json:"projectType"`
+	SuperprojectType string `json:"superprojectType"`
+	Category         string `json:"category"`
+	Subcategory      string `json:"subcategory"`
+	Brief            string `json:"brief"`
+}
+
+// ruleExplanationType is the type for the JSON format rule explanation.
+type ruleExplanationType struct {
+	ruleSummaryType
+	Description     string              `json:"description"`
+	MessageTemplate string              `json:"messageTemplate"`
+	EnabledBy       []string            `json:"enabledBy"`
+	DisabledBy      []string            `json:"disabledBy"`
+	Levels          []modeLevelJSONType `json:"levels"`
+}
+
+// modeLevelJSONType is the type for the JSON format behavior of a rule under a combination of rule modes.
+type modeLevelJSONType struct {
+	Compliance     string `json:"compliance"`
+	LibraryManager string `json:"libraryManager"`
+	Official       bool   `json:"official"`
+	Enabled        bool   `json:"enabled"`
+	Level          string `json:"level,omitempty"`
+}
+
+// List returns the list of the given rules in the given output format.
+func List(ruleConfigurations []ruleconfiguration.Type, format string) (string, error) {
+	switch format {
+	case Text:
+		var list strings.Builder
+		table := tabwriter.NewWriter(&list, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(table, "ID\tPROJECT TYPE\tCATEGORY\tSUBCATEGORY\tBRIEF")
+		for _, ruleConfiguration := range ruleConfigurations {
+			fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n", ruleConfiguration.ID, ruleConfiguration.ProjectType, ruleConfiguration.Category, ruleConfiguration.Subcategory, ruleConfiguration.Brief)
+		}
+		table.Flush()
+		return list.String(), nil
+	case JSON:
+		ruleSummaries := []ruleSummaryType{}
+		for _, ruleConfiguration := range ruleConfigurations {
+			ruleSummaries = append(ruleSummaries, ruleSummary(ruleConfiguration))
+		}
+		return marshalJSON(ruleSummaries)
+	case Markdown:
+		var list strings.Builder
+		list.WriteString("| ID | Project type | Category | Subcategory | Brief |\n")
+		list.WriteString("| --- | --- | --- | --- | --- |\n")
+		for _, ruleConfiguration := range ruleConfigurations {
+			writeMarkdownTableRow(&list, ruleConfiguration.ID, ruleConfiguration.ProjectType.String(), ruleConfiguration.Category, ruleConfiguration.Subcategory, ruleConfiguration.Brief)
+		}
+		return list.String(), nil
+	default:
+		return "", fmt.Errorf("Unsupported output format %s", format)
+	}
+}
+
+// Explain returns the full documentation of the given rule in the given output format.
+func Explain(ruleConfiguration ruleconfiguration.Type, format string) (string, error) {
+	modeLevels, err := ModeLevels(ruleConfiguration)
+	if err != nil {
+		return "", err
+	}
+
+	switch format {
+	case Text:
+		var explanation strings.Builder
+		fmt.Fprintf(&explanation, "%s: %s\n\n", ruleConfiguration.ID, ruleConfiguration.Brief)
+		fmt.Fprintf(&explanation, "Project type: %s\n", ruleConfiguration.ProjectType)
+		fmt.Fprintf(&explanation, "Superproject type: %s\n", ruleConfiguration.SuperprojectType)
+		fmt.Fprintf(&explanation, "Category: %s\n", ruleConfiguration.Category)
+		fmt.Fprintf(&explanation, "Subcategory: %s\n", ruleConfiguration.Subcategory)
+		if ruleConfiguration.Description != "" {
+			fmt.Fprintf(&explanation, "Description: %s\n", ruleConfiguration.Description)
+		}
+		fmt.Fprintf(&explanation, "Message: %s\n", ruleConfiguration.MessageTemplate)
+		fmt.Fprintf(&explanation, "Enabled by: %s\n", modeList(ruleConfiguration.EnableModes, "%s"))
+		fmt.Fprintf(&explanation, "Disabled by: %s\n\n", modeList(ruleConfiguration.DisableModes, "%s"))
+
+		table := tabwriter.NewWriter(&explanation, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(table, "COMPLIANCE\tLIBRARY MANAGER\tOFFICIAL\tLEVEL")
+		for _, modeLevel := range modeLevels {
+			fmt.Fprintf(table, "%s\t%s\t%t\t%s\n", modeLevel.Modes.Compliance, modeLevel.Modes.LibraryManager, modeLevel.Modes.Official, levelText(modeLevel))
+		}
+		table.Flush()
+		return explanation.String(), nil
+	case JSON:
+		explanation := ruleExplanationType{
+			ruleSummaryType: ruleSummary(ruleConfiguration),
+			Description:     ruleConfiguration.Description,
+			MessageTemplate: ruleConfiguration.MessageTemplate,
+			EnabledBy:       modeDescriptions(ruleConfiguration.EnableModes),
+			DisabledBy:      modeDescriptions(ruleConfiguration.DisableModes),
+			Levels:          []modeLevelJSONType{},
+		}
+		for _, modeLevel := range modeLevels {
+			modeLevelJSON := modeLevelJSONType{
+				Compliance:     modeLevel.Modes.Compliance.String(),
+				LibraryManager: modeLevel.Modes.LibraryManager,
+				Official:       modeLevel.Modes.Official,
+				Enabled:        modeLevel.Enabled,
+			}
+			if modeLevel.Enabled {
+				modeLevelJSON.Level = modeLevel.Level.String()
+			}
+			explanation.Levels = append(explanation.Levels, modeLevelJSON)
+		}
+		return marshalJSON(explanation)
+	case Markdown:
+		var explanation strings.Builder
+		fmt.Fprintf(&explanation, "# %s: %s\n\n", ruleConfiguration.ID, ruleConfiguration.Brief)
+		if ruleConfiguration.Description != "" {
+			fmt.Fprintf(&explanation, "%s\n\n", ruleConfiguration.Description)
+		}
+		explanation.WriteString("| | |\n| --- | --- |\n")
+		writeMarkdownTableRow(&explanation, "Project type", ruleConfiguration.ProjectType.String())
+		writeMarkdownTableRow(&explanation, "Superproject type", ruleConfiguration.SuperprojectType.String())
+		writeMarkdownTableRow(&explanation, "Category", ruleConfiguration.Category)
+		writeMarkdownTableRow(&explanation, "Subcategory", ruleConfiguration.Subcategory)
+		writeMarkdownTableRow(&explanation, "Enabled by", modeList(ruleConfiguration.EnableModes, "`%s`"))
+		writeMarkdownTableRow(&explanation, "Disabled by", modeList(ruleConfiguration.DisableModes, "`%s`"))
+		fmt.Fprintf(&explanation, "\n## Message\n\n%s\n", ruleConfiguration.MessageTemplate)
+
+		explanation.WriteString("\n## Levels\n\n")
+		explanation.WriteString("| Compliance | Library Manager | Official | Level |\n")
+		explanation.WriteString("| --- | --- | --- | --- |\n")
+		for _, modeLevel := range modeLevels {
+			writeMarkdownTableRow(&explanation, modeLevel.Modes.Compliance.String(), modeLevel.Modes.LibraryManager, fmt.Sprint(modeLevel.Modes.Official), levelText(modeLevel))
+		}
+		return explanation.String(), nil
+	default:
+		return "", fmt.Errorf("Unsupported output format %s", format)
+	}
+}
+
+// Index returns the Markdown index page of the rule reference, with links to the pages of the given rules.
+func Index(ruleConfigurations []ruleconfiguration.Type) string {
+	var index strings.Builder
+	index.WriteString("# Rule reference\n\n")
+	index.WriteString("| ID | Project type | Category | Subcategory | Brief |\n")
+	index.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, ruleConfiguration := range ruleConfigurations {
+		link := fmt.Sprintf("[%s](%s)", ruleConfiguration.ID, PageFileName(ruleConfiguration))
+		writeMarkdownTableRow(&index, link, ruleConfiguration.ProjectType.String(), ruleConfiguration.Category, ruleConfiguration.Subcategory, ruleConfiguration.Brief)
+	}
+
+	return index.String()
+}
+
+// PageFileName returns the file name of the rule's page in the rule reference.
+func PageFileName(ruleConfiguration ruleconfiguration.Type) string {
+	return ruleConfiguration.ID + ".md"
+}
+
+// ReferencePages returns the Markdown pages of the rule reference, keyed by file name: a page for each rule, and the
+// index.md page.
+func ReferencePages() (map[string]string, error) {
+	pages := map[string]string{
+		"index.md": Index(ruleconfiguration.Configurations()),
+	}
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		page, err := Explain(ruleConfiguration, Markdown)
+		if err != nil {
+			return nil, err
+		}
+		pages[PageFileName(ruleConfiguration)] = page
+	}
+
+	return pages, nil
+}
+
+// ruleSummary returns the JSON format list entry for the rule.
+func ruleSummary(ruleConfiguration ruleconfiguration.Type) ruleSummaryType {
+	return ruleSummaryType{
+		ID:               ruleConfiguration.ID,
+		ProjectType:      ruleConfiguration.ProjectType.String(),
+		SuperprojectType: ruleConfiguration.SuperprojectType.String(),
+		Category:         ruleConfiguration.Category,
+		Subcategory:      ruleConfiguration.Subcategory,
+		Brief:            ruleConfiguration.Brief,
+	}
+}
+
+// levelText returns the human readable level of the rule under the mode combination.
+func levelText(modeLevel ModeLevelType) string {
+	if !modeLevel.Enabled {
+		return "disabled"
+	}
+
+	return modeLevel.Level.String()
+}
+
+// modeDescriptions returns descriptions of the rule modes, in terms of the tool configuration that sets them.
+func modeDescriptions(ruleModes []rulemode.Type) []string {
+	descriptions := []string{}
+	for _, ruleMode := range ruleModes {
+		switch ruleMode {
+		case rulemode.Strict, rulemode.Specification, rulemode.Permissive:
+			descriptions = append(descriptions, "--compliance "+ruleMode.String())
+		case rulemode.LibraryManagerSubmission, rulemode.LibraryManagerIndexed:
+			descriptions = append(descriptions, "--library-manager "+ruleMode.String())
+		case rulemode.Official:
+			descriptions = append(descriptions, ruleMode.String()+"=true")
+		default:
+			descriptions = append(descriptions, ruleMode.String())
+		}
+	}
+
+	return descriptions
+}
+
+// modeList returns the human readable comma-separated list of descriptions of the rule modes, each formatted according
+// to the format specifier.
+func modeList(ruleModes []rulemode.Type, format string) string {
+	if len(ruleModes) == 0 {
+		return "none"
+	}
+
+	items := []string{}
+	for _, description := range modeDescriptions(ruleModes) {
+		items = append(items, fmt.Sprintf(format, description))
+	}
+
+	return strings.Join(items, ", ")
+}
+
+// marshalJSON returns the indented JSON encoding of the data.
+func marshalJSON(data interface{}) (string, error) {
+	dataJSON, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(dataJSON) + "\n", nil
+}
+
+// writeMarkdownTableRow writes a row of a Markdown table with the given cells.
+func writeMarkdownTableRow(table *strings.Builder, cells ...string) {
+	escapedCells := []string{}
+	for _, cell := range cells {
+		escapedCells = append(escapedCells, strings.ReplaceAll(cell, "|", `\|`))
+	}
+	fmt.Fprintf(table, "| %s |\n", strings.Join(escapedCells, " | "))
+}
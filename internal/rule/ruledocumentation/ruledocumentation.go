@@ -0,0 +1,138 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package ruledocumentation generates documentation of the rules from their configuration.
+package ruledocumentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+)
+
+// ModeCombinationType is the type for the combinations of the rule modes the tool can be configured for.
+type ModeCombinationType struct {
+	Compliance     rulemode.Type // Can be {Strict|Specification|Permissive}.
+	LibraryManager string        // Can be {submit|update|false}.
+	Official       bool
+}
+
+// RuleModes returns the rule mode settings of the combination.
+func (modeCombination ModeCombinationType) RuleModes() map[rulemode.Type]bool {
+	return map[rulemode.Type]bool{
+		rulemode.Strict:                   modeCombination.Compliance == rulemode.Strict,
+		rulemode.Specification:            modeCombination.Compliance == rulemode.Specification,
+		rulemode.Permissive:               modeCombination.Compliance == rulemode.Permissive,
+		rulemode.LibraryManagerSubmission: modeCombination.LibraryManager == rulemode.LibraryManagerSubmission.String(),
+		rulemode.LibraryManagerIndexed:    modeCombination.LibraryManager == rulemode.LibraryManagerIndexed.String(),
+		rulemode.Official:                 modeCombination.Official,
+	}
+}
+
+// ModeCombinations returns all combinations of the rule modes the tool can be configured for.
+func ModeCombinations() []ModeCombinationType {
+	modeCombinations := []ModeCombinationType{}
+	for _, compliance := range []rulemode.Type{rulemode.Strict, rulemode.Specification, rulemode.Permissive} {
+		for _, libraryManager := range []string{rulemode.LibraryManagerSubmission.String(), rulemode.LibraryManagerIndexed.String(), "false"} {
+			for _, official := range []bool{false, true} {
+				modeCombinations = append(modeCombinations, ModeCombinationType{Compliance: compliance, LibraryManager: libraryManager, Official: official})
+			}
+		}
+	}
+
+	return modeCombinations
+}
+
+// ModeLevelType is the type for the behavior of a rule under a combination of rule modes.
+type ModeLevelType struct {
+	Modes   ModeCombinationType
+	Enabled bool
+	Level   rulelevel.Type // The level of a violation of the rule. Only applicable when the rule is enabled.
+}
+
+// ModeLevels returns the behavior of the rule under each combination of rule modes.
+func ModeLevels(ruleConfiguration ruleconfiguration.Type) ([]ModeLevelType, error) {
+	modeLevels := []ModeLevelType{}
+	for _, modeCombination := range ModeCombinations() {
+		modeLevel := ModeLevelType{Modes: modeCombination}
+
+		var err error
+		modeLevel.Enabled, err = rule.IsEnabled(ruleConfiguration, modeCombination.RuleModes())
+		if err != nil {
+			return nil, err
+		}
+		if modeLevel.Enabled {
+			modeLevel.Level, err = rulelevel.FailRuleLevel(ruleConfiguration, modeCombination.RuleModes())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		modeLevels = append(modeLevels, modeLevel)
+	}
+
+	return modeLevels, nil
+}
+
+// FilterType is the type for the criteria rules are selected by.
+type FilterType struct {
+	ProjectType projecttype.Type                                          // projecttype.All selects rules for any project type.
+	Category    string                                                    // Empty selects rules of any category.
+	RuleModes   func(projectType projecttype.Type) map[rulemode.Type]bool // Rule modes for the rules of the project type. nil selects rules regardless of whether they are enabled.
+}
+
+// Select returns the configurations of the rules that meet the criteria of the filter.
+func Select(filter FilterType) ([]ruleconfiguration.Type, error) {
+	selectedRules := []ruleconfiguration.Type{}
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		if filter.ProjectType != projecttype.All && ruleConfiguration.ProjectType != filter.ProjectType {
+			continue
+		}
+
+		if filter.Category != "" && !strings.EqualFold(ruleConfiguration.Category, filter.Category) {
+			continue
+		}
+
+		if filter.RuleModes != nil {
+			enabled, err := rule.IsEnabled(ruleConfiguration, filter.RuleModes(ruleConfiguration.ProjectType))
+			if err != nil {
+				return nil, err
+			}
+			if !enabled {
+				continue
+			}
+		}
+
+		selectedRules = append(selectedRules, ruleConfiguration)
+	}
+
+	return selectedRules, nil
+}
+
+// Find returns the configuration of the rule with the given ID.
+func Find(ruleID string) (ruleconfiguration.Type, error) {
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		if strings.EqualFold(ruleConfiguration.ID, ruleID) {
+			return ruleConfiguration, nil
+		}
+	}
+
+	return ruleconfiguration.Type{}, fmt.Errorf("No rule with ID %s", ruleID)
+}
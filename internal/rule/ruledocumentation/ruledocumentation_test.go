@@ -0,0 +1,205 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package ruledocumentation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModeCombinations(t *testing.T) {
+	modeCombinations := ModeCombinations()
+	assert.Len(t, modeCombinations, 3*3*2)
+	assert.Contains(t, modeCombinations, ModeCombinationType{Compliance: rulemode.Permissive, LibraryManager: "update", Official: true})
+
+	ruleModes := ModeCombinationType{Compliance: rulemode.Strict, LibraryManager: "submit", Official: false}.RuleModes()
+	assert.True(t, ruleModes[rulemode.Strict])
+	assert.False(t, ruleModes[rulemode.Specification])
+	assert.True(t, ruleModes[rulemode.LibraryManagerSubmission])
+	assert.False(t, ruleModes[rulemode.LibraryManagerIndexed])
+	assert.False(t, ruleModes[rulemode.Official])
+}
+
+func TestModeLevels(t *testing.T) {
+	ruleConfiguration := ruleconfiguration.Type{
+		ID:           "XX001",
+		DisableModes: []rulemode.Type{rulemode.LibraryManagerIndexed},
+		EnableModes:  []rulemode.Type{rulemode.Default},
+		WarningModes: []rulemode.Type{rulemode.Default},
+		ErrorModes:   []rulemode.Type{rulemode.Strict},
+	}
+
+	modeLevels, err := ModeLevels(ruleConfiguration)
+	require.Nil(t, err)
+	require.Len(t, modeLevels, len(ModeCombinations()))
+	for _, modeLevel := range modeLevels {
+		assert.Equal(t, modeLevel.Modes.LibraryManager != "update", modeLevel.Enabled, modeLevel.Modes)
+		if modeLevel.Enabled {
+			if modeLevel.Modes.Compliance == rulemode.Strict {
+				assert.Equal(t, rulelevel.Error, modeLevel.Level, modeLevel.Modes)
+			} else {
+				assert.Equal(t, rulelevel.Warning, modeLevel.Level, modeLevel.Modes)
+			}
+		}
+	}
+
+	ruleConfiguration.EnableModes = nil
+	_, err = ModeLevels(ruleConfiguration)
+	assert.Error(t, err, "Incorrectly configured rule")
+}
+
+func TestSelect(t *testing.T) {
+	allRules, err := Select(FilterType{ProjectType: projecttype.All})
+	require.Nil(t, err)
+	assert.Len(t, allRules, len(ruleconfiguration.Configurations()))
+
+	libraryStructureRules, err := Select(FilterType{ProjectType: projecttype.Library, Category: "Structure"})
+	require.Nil(t, err)
+	assert.NotEmpty(t, libraryStructureRules)
+	for _, ruleConfiguration := range libraryStructureRules {
+		assert.Equal(t, projecttype.Library, ruleConfiguration.ProjectType)
+		assert.Equal(t, "structure", ruleConfiguration.Category)
+	}
+
+	permissiveRules, err := Select(FilterType{
+		ProjectType: projecttype.All,
+		RuleModes: func(projecttype.Type) map[rulemode.Type]bool {
+			return ModeCombinationType{Compliance: rulemode.Permissive, LibraryManager: "false"}.RuleModes()
+		},
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, permissiveRules)
+	assert.Less(t, len(permissiveRules), len(allRules), "Rules not enabled in the modes are not selected")
+
+	updateRules, err := Select(FilterType{
+		ProjectType: projecttype.All,
+		RuleModes: func(projectType projecttype.Type) map[rulemode.Type]bool {
+			libraryManager := "false"
+			if projectType == projecttype.Library {
+				libraryManager = "update"
+			}
+			return ModeCombinationType{Compliance: rulemode.Specification, LibraryManager: libraryManager}.RuleModes()
+		},
+	})
+	require.Nil(t, err)
+	updateRuleIDs := []string{}
+	for _, ruleConfiguration := range updateRules {
+		updateRuleIDs = append(updateRuleIDs, ruleConfiguration.ID)
+	}
+	assert.Contains(t, updateRuleIDs, "LP018", "Rule modes of the rule's project type")
+	assert.NotContains(t, updateRuleIDs, "LP017", "Rule modes of the rule's project type")
+}
+
+func TestFind(t *testing.T) {
+	ruleConfiguration, err := Find("lp006")
+	require.Nil(t, err)
+	assert.Equal(t, "LP006", ruleConfiguration.ID)
+
+	_, err = Find("XX999")
+	assert.Error(t, err)
+}
+
+func TestList(t *testing.T) {
+	ruleConfigurations := ruleconfiguration.Configurations()[:2]
+
+	list, err := List(ruleConfigurations, Text)
+	require.Nil(t, err)
+	assert.Regexp(t, `^ID +PROJECT TYPE +CATEGORY +SUBCATEGORY +BRIEF\n`, list)
+	assert.Contains(t, list, ruleConfigurations[1].Brief)
+
+	list, err = List(ruleConfigurations, JSON)
+	require.Nil(t, err)
+	var ruleSummaries []ruleSummaryType
+	require.Nil(t, json.Unmarshal([]byte(list), &ruleSummaries))
+	assert.Equal(t, ruleConfigurations[0].ID, ruleSummaries[0].ID)
+	assert.Len(t, ruleSummaries, 2)
+
+	list, err = List(ruleConfigurations, Markdown)
+	require.Nil(t, err)
+	assert.Contains(t, list, "| "+ruleConfigurations[0].ID+" | ")
+
+	_, err = List(ruleConfigurations, "foo")
+	assert.Error(t, err)
+}
+
+func TestExplain(t *testing.T) {
+	ruleConfiguration, err := Find("LP006")
+	require.Nil(t, err)
+
+	explanation, err := Explain(ruleConfiguration, Text)
+	require.Nil(t, err)
+	assert.Contains(t, explanation, "LP006: "+ruleConfiguration.Brief)
+	assert.Contains(t, explanation, ruleConfiguration.MessageTemplate)
+	assert.Regexp(t, `\nstrict +submit +false +ERROR\n`, explanation)
+	assert.Contains(t, explanation, "Enabled by: default\n")
+	assert.Contains(t, explanation, "Disabled by: none\n")
+
+	explanation, err = Explain(ruleConfiguration, JSON)
+	require.Nil(t, err)
+	var ruleExplanation ruleExplanationType
+	require.Nil(t, json.Unmarshal([]byte(explanation), &ruleExplanation))
+	assert.Equal(t, "LP006", ruleExplanation.ID)
+	assert.Len(t, ruleExplanation.Levels, len(ModeCombinations()))
+	assert.Equal(t, modeLevelJSONType{Compliance: "strict", LibraryManager: "submit", Official: false, Enabled: true, Level: "ERROR"}, ruleExplanation.Levels[0])
+	assert.Equal(t, []string{"default"}, ruleExplanation.EnabledBy)
+	assert.Empty(t, ruleExplanation.DisabledBy)
+
+	explanation, err = Explain(ruleConfiguration, Markdown)
+	require.Nil(t, err)
+	assert.Contains(t, explanation, "# LP006: "+ruleConfiguration.Brief)
+	assert.Contains(t, explanation, "| strict | submit | false | ERROR |")
+	assert.Contains(t, explanation, "| Enabled by | `default` |")
+}
+
+func TestModeDescriptions(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"--compliance strict", "--library-manager submit", "ARDUINO_LINT_OFFICIAL=true", "default"},
+		modeDescriptions([]rulemode.Type{rulemode.Strict, rulemode.LibraryManagerSubmission, rulemode.Official, rulemode.Default}),
+	)
+	assert.Empty(t, modeDescriptions(nil))
+}
+
+func TestModeList(t *testing.T) {
+	assert.Equal(t, "`--compliance strict`, `--library-manager update`", modeList([]rulemode.Type{rulemode.Strict, rulemode.LibraryManagerIndexed}, "`%s`"))
+	assert.Equal(t, "none", modeList(nil, "%s"))
+}
+
+func TestReferencePages(t *testing.T) {
+	pages, err := ReferencePages()
+	require.Nil(t, err)
+	assert.Len(t, pages, len(ruleconfiguration.Configurations())+1, "A page for each rule, plus the index")
+
+	ruleConfiguration, err := Find("LP006")
+	require.Nil(t, err)
+	assert.Contains(t, pages["index.md"], "| [LP006](LP006.md) | ")
+	assert.Contains(t, pages["LP006.md"], "# LP006: "+ruleConfiguration.Brief)
+}
+
+func TestWriteMarkdownTableRow(t *testing.T) {
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	ruleConfiguration.Brief = "foo | bar"
+	list, err := List([]ruleconfiguration.Type{ruleConfiguration}, Markdown)
+	require.Nil(t, err)
+	assert.Contains(t, list, `foo \| bar`, "Cell content is escaped")
+}
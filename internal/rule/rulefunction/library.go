@@ -22,16 +22,21 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project/library"
+	"github.com/arduino/arduino-lint/internal/project/library/libraryproperties"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/sketch"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
+	"github.com/arduino/arduino-lint/internal/util/httpcache"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -40,41 +45,45 @@ import (
 )
 
 // LibraryInvalid checks whether the provided path is a valid library.
-func LibraryInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() != nil && library.ContainsHeaderFile(projectdata.LoadedLibrary().SourceDir) {
+func LibraryInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LoadedLibrary() != nil && library.ContainsHeaderFile(projectData.LoadedLibrary().SourceDir) {
 		return ruleresult.Pass, ""
 	}
 
+	reportPathLocation(projectData, projectData.ProjectPath())
 	return ruleresult.Fail, ""
 }
 
 // LibraryFolderNameGTMaxLength checks if the library folder name exceeds the maximum length.
-func LibraryFolderNameGTMaxLength() (result ruleresult.Type, output string) {
-	if len(projectdata.ProjectPath().Base()) > 63 {
-		return ruleresult.Fail, projectdata.ProjectPath().Base()
+func LibraryFolderNameGTMaxLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if len(projectData.ProjectPath().Base()) > 63 {
+		reportPathLocation(projectData, projectData.ProjectPath())
+		return ruleresult.Fail, projectData.ProjectPath().Base()
 	}
 
 	return ruleresult.Pass, ""
 }
 
 // ProhibitedCharactersInLibraryFolderName checks for prohibited characters in the library folder name.
-func ProhibitedCharactersInLibraryFolderName() (result ruleresult.Type, output string) {
-	if !validProjectPathBaseName(projectdata.ProjectPath().Base()) {
-		return ruleresult.Fail, projectdata.ProjectPath().Base()
+func ProhibitedCharactersInLibraryFolderName(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !validProjectPathBaseName(projectData.ProjectPath().Base()) {
+		reportPathLocation(projectData, projectData.ProjectPath())
+		return ruleresult.Fail, projectData.ProjectPath().Base()
 	}
 
 	return ruleresult.Pass, ""
 }
 
 // LibraryHasSubmodule checks whether the library contains a Git submodule.
-func LibraryHasSubmodule() (result ruleresult.Type, output string) {
-	dotGitmodulesPath := projectdata.ProjectPath().Join(".gitmodules")
+func LibraryHasSubmodule(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	dotGitmodulesPath := projectData.ProjectPath().Join(".gitmodules")
 	hasDotGitmodules, err := dotGitmodulesPath.ExistCheck()
 	if err != nil {
 		panic(err)
 	}
 
 	if hasDotGitmodules && dotGitmodulesPath.IsNotDir() {
+		reportPathLocation(projectData, dotGitmodulesPath)
 		return ruleresult.Fail, ""
 	}
 
@@ -82,8 +91,8 @@ func LibraryHasSubmodule() (result ruleresult.Type, output string) {
 }
 
 // LibraryContainsSymlinks checks if the library folder contains symbolic links.
-func LibraryContainsSymlinks() (result ruleresult.Type, output string) {
-	projectPathListing, err := projectdata.ProjectPath().ReadDirRecursive()
+func LibraryContainsSymlinks(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	projectPathListing, err := projectData.ProjectPath().ReadDirRecursive()
 	if err != nil {
 		panic(err)
 	}
@@ -98,6 +107,7 @@ func LibraryContainsSymlinks() (result ruleresult.Type, output string) {
 
 		if projectPathItemStat.Mode()&os.ModeSymlink != 0 {
 			symlinkPaths = append(symlinkPaths, projectPathItem.String())
+			reportPathLocation(projectData, projectPathItem)
 		}
 	}
 
@@ -109,14 +119,15 @@ func LibraryContainsSymlinks() (result ruleresult.Type, output string) {
 }
 
 // LibraryHasDotDevelopmentFile checks whether the library contains a .development flag file.
-func LibraryHasDotDevelopmentFile() (result ruleresult.Type, output string) {
-	dotDevelopmentPath := projectdata.ProjectPath().Join(".development")
+func LibraryHasDotDevelopmentFile(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	dotDevelopmentPath := projectData.ProjectPath().Join(".development")
 	hasDotDevelopment, err := dotDevelopmentPath.ExistCheck()
 	if err != nil {
 		panic(err)
 	}
 
 	if hasDotDevelopment && dotDevelopmentPath.IsNotDir() {
+		reportPathLocation(projectData, dotDevelopmentPath)
 		return ruleresult.Fail, ""
 	}
 
@@ -124,8 +135,8 @@ func LibraryHasDotDevelopmentFile() (result ruleresult.Type, output string) {
 }
 
 // LibraryHasExe checks whether the library contains files with .exe extension.
-func LibraryHasExe() (result ruleresult.Type, output string) {
-	projectPathListing, err := projectdata.ProjectPath().ReadDirRecursive()
+func LibraryHasExe(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	projectPathListing, err := projectData.ProjectPath().ReadDirRecursive()
 	if err != nil {
 		panic(err)
 	}
@@ -135,6 +146,7 @@ func LibraryHasExe() (result ruleresult.Type, output string) {
 	for _, projectPathItem := range projectPathListing {
 		if projectPathItem.Ext() == ".exe" {
 			exePaths = append(exePaths, projectPathItem.String())
+			reportPathLocation(projectData, projectPathItem)
 		}
 	}
 
@@ -146,35 +158,36 @@ func LibraryHasExe() (result ruleresult.Type, output string) {
 }
 
 // LibraryPropertiesNameFieldHeaderMismatch checks whether the filename of one of the library's header files matches the Library Manager installation folder name.
-func LibraryPropertiesNameFieldHeaderMismatch() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldHeaderMismatch(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
 	sanitizedName := utils.SanitizeName(name)
-	for _, header := range projectdata.SourceHeaders() {
+	for _, header := range projectData.SourceHeaders() {
 		if strings.TrimSuffix(header, filepath.Ext(header)) == sanitizedName {
 			return ruleresult.Pass, ""
 		}
 	}
 
+	reportPropertyLocation(projectData, "library.properties", "name")
 	return ruleresult.Fail, sanitizedName + ".h"
 }
 
 // IncorrectLibrarySrcFolderNameCase checks for incorrect case of src subfolder name in recursive format libraries.
-func IncorrectLibrarySrcFolderNameCase() (result ruleresult.Type, output string) {
-	if library.ContainsMetadataFile(projectdata.ProjectPath()) && library.ContainsHeaderFile(projectdata.ProjectPath()) {
+func IncorrectLibrarySrcFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if library.ContainsMetadataFile(projectData.ProjectPath()) && library.ContainsHeaderFile(projectData.ProjectPath()) {
 		// Flat layout, so no special treatment of src subfolder.
 		return ruleresult.Skip, "Not applicable due to layout type"
 	}
 
 	// The library is intended to have the recursive layout.
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -182,6 +195,7 @@ func IncorrectLibrarySrcFolderNameCase() (result ruleresult.Type, output string)
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "src")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -189,16 +203,17 @@ func IncorrectLibrarySrcFolderNameCase() (result ruleresult.Type, output string)
 }
 
 // RecursiveLibraryWithUtilityFolder checks for presence of a `utility` subfolder in a recursive layout library.
-func RecursiveLibraryWithUtilityFolder() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil {
+func RecursiveLibraryWithUtilityFolder(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LoadedLibrary() == nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	if projectdata.LoadedLibrary().Layout == libraries.FlatLayout {
+	if projectData.LoadedLibrary().Layout == libraries.FlatLayout {
 		return ruleresult.Skip, "Not applicable due to layout type"
 	}
 
-	if projectdata.ProjectPath().Join("utility").Exist() {
+	if projectData.ProjectPath().Join("utility").Exist() {
+		reportPathLocation(projectData, projectData.ProjectPath().Join("utility"))
 		return ruleresult.Fail, ""
 	}
 
@@ -206,8 +221,8 @@ func RecursiveLibraryWithUtilityFolder() (result ruleresult.Type, output string)
 }
 
 // MisspelledExtrasFolderName checks for incorrectly spelled `extras` folder name.
-func MisspelledExtrasFolderName() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func MisspelledExtrasFolderName(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -215,6 +230,7 @@ func MisspelledExtrasFolderName() (result ruleresult.Type, output string) {
 
 	path, found := containsMisspelledPathBaseName(directoryListing, "extras", "(?i)^extra$")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -222,8 +238,8 @@ func MisspelledExtrasFolderName() (result ruleresult.Type, output string) {
 }
 
 // IncorrectExtrasFolderNameCase checks for incorrect `extras` folder name case.
-func IncorrectExtrasFolderNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectExtrasFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -231,6 +247,7 @@ func IncorrectExtrasFolderNameCase() (result ruleresult.Type, output string) {
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "extras")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -238,12 +255,13 @@ func IncorrectExtrasFolderNameCase() (result ruleresult.Type, output string) {
 }
 
 // LibraryPropertiesMissing checks for presence of library.properties.
-func LibraryPropertiesMissing() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil {
+func LibraryPropertiesMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LoadedLibrary() == nil {
 		return ruleresult.NotRun, "Couldn't load library."
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
+		reportPathLocation(projectData, projectData.ProjectPath())
 		return ruleresult.Fail, ""
 	}
 
@@ -251,8 +269,8 @@ func LibraryPropertiesMissing() (result ruleresult.Type, output string) {
 }
 
 // MisspelledLibraryPropertiesFileName checks for incorrectly spelled library.properties file name.
-func MisspelledLibraryPropertiesFileName() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func MisspelledLibraryPropertiesFileName(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -260,6 +278,7 @@ func MisspelledLibraryPropertiesFileName() (result ruleresult.Type, output strin
 
 	path, found := containsMisspelledPathBaseName(directoryListing, "library.properties", "(?i)^librar((y)|(ie))s?[.-_]?propert((y)|(ie))s?$")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -267,8 +286,8 @@ func MisspelledLibraryPropertiesFileName() (result ruleresult.Type, output strin
 }
 
 // IncorrectLibraryPropertiesFileNameCase checks for incorrect library.properties file name case.
-func IncorrectLibraryPropertiesFileNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectLibraryPropertiesFileNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -276,6 +295,7 @@ func IncorrectLibraryPropertiesFileNameCase() (result ruleresult.Type, output st
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "library.properties")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -283,9 +303,10 @@ func IncorrectLibraryPropertiesFileNameCase() (result ruleresult.Type, output st
 }
 
 // RedundantLibraryProperties checks for redundant copies of the library.properties file.
-func RedundantLibraryProperties() (result ruleresult.Type, output string) {
-	redundantLibraryPropertiesPath := projectdata.ProjectPath().Join("src", "library.properties")
+func RedundantLibraryProperties(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	redundantLibraryPropertiesPath := projectData.ProjectPath().Join("src", "library.properties")
 	if redundantLibraryPropertiesPath.Exist() {
+		reportPathLocation(projectData, redundantLibraryPropertiesPath)
 		return ruleresult.Fail, redundantLibraryPropertiesPath.String()
 	}
 
@@ -293,45 +314,48 @@ func RedundantLibraryProperties() (result ruleresult.Type, output string) {
 }
 
 // LibraryPropertiesFormat checks for invalid library.properties format.
-func LibraryPropertiesFormat() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
+func LibraryPropertiesFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has no library.properties"
 	}
 
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.Fail, projectdata.LibraryPropertiesLoadError().Error()
+	if projectData.LibraryPropertiesLoadError() != nil {
+		reportPathLocation(projectData, projectData.ProjectPath().Join("library.properties"))
+		return ruleresult.Fail, projectData.LibraryPropertiesLoadError().Error()
 	}
 
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesNameFieldMissing checks for missing library.properties "name" field.
-func LibraryPropertiesNameFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesNameFieldLTMinLength checks if the library.properties "name" value is less than the minimum length.
-func LibraryPropertiesNameFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("name") {
+	if !projectData.LibraryProperties().ContainsKey("name") {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, ""
 	}
 
@@ -339,17 +363,18 @@ func LibraryPropertiesNameFieldLTMinLength() (result ruleresult.Type, output str
 }
 
 // LibraryPropertiesNameFieldGTMaxLength checks if the library.properties "name" value is greater than the maximum length.
-func LibraryPropertiesNameFieldGTMaxLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldGTMaxLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyGreaterThanMaxLength("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyGreaterThanMaxLength("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -357,17 +382,18 @@ func LibraryPropertiesNameFieldGTMaxLength() (result ruleresult.Type, output str
 }
 
 // LibraryPropertiesNameFieldGTRecommendedLength checks if the library.properties "name" value is greater than the recommended length.
-func LibraryPropertiesNameFieldGTRecommendedLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldGTRecommendedLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyGreaterThanMaxLength("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyGreaterThanMaxLength("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -375,17 +401,18 @@ func LibraryPropertiesNameFieldGTRecommendedLength() (result ruleresult.Type, ou
 }
 
 // LibraryPropertiesNameFieldDisallowedCharacters checks for disallowed characters in the library.properties "name" field.
-func LibraryPropertiesNameFieldDisallowedCharacters() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldDisallowedCharacters(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/allowedCharacters", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/allowedCharacters", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -393,17 +420,18 @@ func LibraryPropertiesNameFieldDisallowedCharacters() (result ruleresult.Type, o
 }
 
 // LibraryPropertiesNameFieldStartsWithArduino checks if the library.properties "name" value starts with "Arduino".
-func LibraryPropertiesNameFieldStartsWithArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldStartsWithArduino(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notStartsWithArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notStartsWithArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -411,12 +439,12 @@ func LibraryPropertiesNameFieldStartsWithArduino() (result ruleresult.Type, outp
 }
 
 // LibraryPropertiesNameFieldMissingOfficialPrefix checks whether the library.properties `name` value uses the prefix required of all new official Arduino libraries.
-func LibraryPropertiesNameFieldMissingOfficialPrefix() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldMissingOfficialPrefix(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
@@ -424,21 +452,23 @@ func LibraryPropertiesNameFieldMissingOfficialPrefix() (result ruleresult.Type,
 	if strings.HasPrefix(name, "Arduino_") {
 		return ruleresult.Pass, ""
 	}
+	reportPropertyLocation(projectData, "library.properties", "name")
 	return ruleresult.Fail, name
 }
 
 // LibraryPropertiesNameFieldContainsArduino checks if the library.properties "name" value contains "Arduino".
-func LibraryPropertiesNameFieldContainsArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldContainsArduino(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -446,17 +476,18 @@ func LibraryPropertiesNameFieldContainsArduino() (result ruleresult.Type, output
 }
 
 // LibraryPropertiesNameFieldHasSpaces checks if the library.properties "name" value contains spaces.
-func LibraryPropertiesNameFieldHasSpaces() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldHasSpaces(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSpaces", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSpaces", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -464,17 +495,18 @@ func LibraryPropertiesNameFieldHasSpaces() (result ruleresult.Type, output strin
 }
 
 // LibraryPropertiesNameFieldContainsLibrary checks if the library.properties "name" value contains "library".
-func LibraryPropertiesNameFieldContainsLibrary() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldContainsLibrary(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSuperfluousTerms", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSuperfluousTerms", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -482,17 +514,22 @@ func LibraryPropertiesNameFieldContainsLibrary() (result ruleresult.Type, output
 }
 
 // LibraryPropertiesNameFieldDuplicate checks whether there is an existing entry in the Library Manager index using the library.properties `name` value.
-func LibraryPropertiesNameFieldDuplicate() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldDuplicate(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, hasName := projectdata.LibraryProperties().GetOk("name")
+	name, hasName := projectData.LibraryProperties().GetOk("name")
 	if !hasName {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if nameInLibraryManagerIndex(name) {
+	if projectData.LibraryManagerIndexLoadError() != nil {
+		return ruleresult.Skip, projectData.LibraryManagerIndexLoadError().Error()
+	}
+
+	if nameInLibraryManagerIndex(projectData, name) {
+		reportPropertyLocation(projectData, "library.properties", "name")
 		return ruleresult.Fail, name
 	}
 
@@ -500,51 +537,58 @@ func LibraryPropertiesNameFieldDuplicate() (result ruleresult.Type, output strin
 }
 
 // LibraryPropertiesNameFieldNotInIndex checks whether there is no existing entry in the Library Manager index using the library.properties `name` value.
-func LibraryPropertiesNameFieldNotInIndex() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesNameFieldNotInIndex(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	name, hasName := projectdata.LibraryProperties().GetOk("name")
+	name, hasName := projectData.LibraryProperties().GetOk("name")
 	if !hasName {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if nameInLibraryManagerIndex(name) {
+	if projectData.LibraryManagerIndexLoadError() != nil {
+		return ruleresult.Skip, projectData.LibraryManagerIndexLoadError().Error()
+	}
+
+	if nameInLibraryManagerIndex(projectData, name) {
 		return ruleresult.Pass, ""
 	}
 
+	reportPropertyLocation(projectData, "library.properties", "name")
 	return ruleresult.Fail, name
 }
 
 // LibraryPropertiesVersionFieldMissing checks for missing library.properties "version" field.
-func LibraryPropertiesVersionFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesVersionFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("version", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("version", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "version")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesVersionFieldNonRelaxedSemver checks whether the library.properties "version" value is "relaxed semver" compliant.
-func LibraryPropertiesVersionFieldNonRelaxedSemver() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesVersionFieldNonRelaxedSemver(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	version, ok := projectdata.LibraryProperties().GetOk("version")
+	version, ok := projectData.LibraryProperties().GetOk("version")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyPatternMismatch("version", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "version")
 		return ruleresult.Fail, version
 	}
 
@@ -552,17 +596,18 @@ func LibraryPropertiesVersionFieldNonRelaxedSemver() (result ruleresult.Type, ou
 }
 
 // LibraryPropertiesVersionFieldNonSemver checks whether the library.properties "version" value is semver compliant.
-func LibraryPropertiesVersionFieldNonSemver() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesVersionFieldNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	version, ok := projectdata.LibraryProperties().GetOk("version")
+	version, ok := projectData.LibraryProperties().GetOk("version")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("version", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "library.properties", "version")
 		return ruleresult.Fail, version
 	}
 
@@ -570,12 +615,12 @@ func LibraryPropertiesVersionFieldNonSemver() (result ruleresult.Type, output st
 }
 
 // LibraryPropertiesVersionFieldBehindTag checks whether a release tag was made without first bumping the library.properties version value.
-func LibraryPropertiesVersionFieldBehindTag() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesVersionFieldBehindTag(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	versionString, ok := projectdata.LibraryProperties().GetOk("version")
+	versionString, ok := projectData.LibraryProperties().GetOk("version")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
@@ -586,7 +631,7 @@ func LibraryPropertiesVersionFieldBehindTag() (result ruleresult.Type, output st
 	}
 	logrus.Tracef("version value: %s", version)
 
-	repository, err := git.PlainOpen(projectdata.ProjectPath().String())
+	repository, err := git.PlainOpen(projectData.ProjectPath().String())
 	if err != nil {
 		return ruleresult.Skip, "Project path is not a repository"
 	}
@@ -647,6 +692,7 @@ func LibraryPropertiesVersionFieldBehindTag() (result ruleresult.Type, output st
 						break
 					}
 
+					reportPropertyLocation(projectData, "library.properties", "version")
 					return ruleresult.Fail, fmt.Sprintf("%s vs %s", tagName, versionString)
 				}
 
@@ -659,32 +705,34 @@ func LibraryPropertiesVersionFieldBehindTag() (result ruleresult.Type, output st
 }
 
 // LibraryPropertiesAuthorFieldMissing checks for missing library.properties "author" field.
-func LibraryPropertiesAuthorFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesAuthorFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("author", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("author", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "author")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesAuthorFieldLTMinLength checks if the library.properties "author" value is less than the minimum length.
-func LibraryPropertiesAuthorFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesAuthorFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("author") {
+	if !projectData.LibraryProperties().ContainsKey("author") {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("author", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("author", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "author")
 		return ruleresult.Fail, ""
 	}
 
@@ -692,32 +740,34 @@ func LibraryPropertiesAuthorFieldLTMinLength() (result ruleresult.Type, output s
 }
 
 // LibraryPropertiesMaintainerFieldMissing checks for missing library.properties "maintainer" field.
-func LibraryPropertiesMaintainerFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesMaintainerFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("maintainer", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("maintainer", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "maintainer")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesMaintainerFieldLTMinLength checks if the library.properties "maintainer" value is less than the minimum length.
-func LibraryPropertiesMaintainerFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesMaintainerFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("maintainer") {
+	if !projectData.LibraryProperties().ContainsKey("maintainer") {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("maintainer", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("maintainer", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "maintainer")
 		return ruleresult.Fail, ""
 	}
 
@@ -725,17 +775,18 @@ func LibraryPropertiesMaintainerFieldLTMinLength() (result ruleresult.Type, outp
 }
 
 // LibraryPropertiesMaintainerFieldStartsWithArduino checks if the library.properties "maintainer" value starts with "Arduino".
-func LibraryPropertiesMaintainerFieldStartsWithArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesMaintainerFieldStartsWithArduino(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	maintainer, ok := projectdata.LibraryProperties().GetOk("maintainer")
+	maintainer, ok := projectData.LibraryProperties().GetOk("maintainer")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/maintainer$", "/patternObjects/notStartsWithArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.ValidationErrorMatch("^#/maintainer$", "/patternObjects/notStartsWithArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "maintainer")
 		return ruleresult.Fail, maintainer
 	}
 
@@ -743,16 +794,17 @@ func LibraryPropertiesMaintainerFieldStartsWithArduino() (result ruleresult.Type
 }
 
 // LibraryPropertiesEmailFieldAsMaintainerAlias checks whether the library.properties "email" field is being used as an alias for the "maintainer" field.
-func LibraryPropertiesEmailFieldAsMaintainerAlias() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesEmailFieldAsMaintainerAlias(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("email") {
+	if !projectData.LibraryProperties().ContainsKey("email") {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("maintainer") {
+	if !projectData.LibraryProperties().ContainsKey("maintainer") {
+		reportPropertyLocation(projectData, "library.properties", "email")
 		return ruleresult.Fail, ""
 	}
 
@@ -760,16 +812,17 @@ func LibraryPropertiesEmailFieldAsMaintainerAlias() (result ruleresult.Type, out
 }
 
 // LibraryPropertiesEmailFieldLTMinLength checks if the library.properties "email" value is less than the minimum length.
-func LibraryPropertiesEmailFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesEmailFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LibraryProperties().ContainsKey("maintainer") || !projectdata.LibraryProperties().ContainsKey("email") {
+	if projectData.LibraryProperties().ContainsKey("maintainer") || !projectData.LibraryProperties().ContainsKey("email") {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("email", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("email", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "email")
 		return ruleresult.Fail, ""
 	}
 
@@ -777,21 +830,22 @@ func LibraryPropertiesEmailFieldLTMinLength() (result ruleresult.Type, output st
 }
 
 // LibraryPropertiesEmailFieldStartsWithArduino checks if the library.properties "email" value starts with "Arduino".
-func LibraryPropertiesEmailFieldStartsWithArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesEmailFieldStartsWithArduino(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LibraryProperties().ContainsKey("maintainer") {
+	if projectData.LibraryProperties().ContainsKey("maintainer") {
 		return ruleresult.Skip, "No email alias field"
 	}
 
-	email, ok := projectdata.LibraryProperties().GetOk("email")
+	email, ok := projectData.LibraryProperties().GetOk("email")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/email$", "/patternObjects/notStartsWithArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.ValidationErrorMatch("^#/email$", "/patternObjects/notStartsWithArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "email")
 		return ruleresult.Fail, email
 	}
 
@@ -799,32 +853,34 @@ func LibraryPropertiesEmailFieldStartsWithArduino() (result ruleresult.Type, out
 }
 
 // LibraryPropertiesSentenceFieldMissing checks for missing library.properties "sentence" field.
-func LibraryPropertiesSentenceFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesSentenceFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("sentence", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("sentence", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "sentence")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesSentenceFieldLTMinLength checks if the library.properties "sentence" value is less than the minimum length.
-func LibraryPropertiesSentenceFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesSentenceFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("sentence") {
+	if !projectData.LibraryProperties().ContainsKey("sentence") {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("sentence", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("sentence", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "sentence")
 		return ruleresult.Fail, ""
 	}
 
@@ -832,78 +888,82 @@ func LibraryPropertiesSentenceFieldLTMinLength() (result ruleresult.Type, output
 }
 
 // LibraryPropertiesSentenceFieldSpellCheck checks for commonly misspelled words in the library.properties `sentence` field value.
-func LibraryPropertiesSentenceFieldSpellCheck() (result ruleresult.Type, output string) {
-	return spellCheckLibraryPropertiesFieldValue("sentence")
+func LibraryPropertiesSentenceFieldSpellCheck(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	return spellCheckLibraryPropertiesFieldValue(projectData, "sentence")
 }
 
 // LibraryPropertiesParagraphFieldMissing checks for missing library.properties "paragraph" field.
-func LibraryPropertiesParagraphFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesParagraphFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("paragraph", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("paragraph", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "paragraph")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesParagraphFieldSpellCheck checks for commonly misspelled words in the library.properties `paragraph` field value.
-func LibraryPropertiesParagraphFieldSpellCheck() (result ruleresult.Type, output string) {
-	return spellCheckLibraryPropertiesFieldValue("paragraph")
+func LibraryPropertiesParagraphFieldSpellCheck(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	return spellCheckLibraryPropertiesFieldValue(projectData, "paragraph")
 }
 
 // LibraryPropertiesParagraphFieldRepeatsSentence checks whether the library.properties `paragraph` value repeats the `sentence` value.
-func LibraryPropertiesParagraphFieldRepeatsSentence() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesParagraphFieldRepeatsSentence(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	sentence, hasSentence := projectdata.LibraryProperties().GetOk("sentence")
-	paragraph, hasParagraph := projectdata.LibraryProperties().GetOk("paragraph")
+	sentence, hasSentence := projectData.LibraryProperties().GetOk("sentence")
+	paragraph, hasParagraph := projectData.LibraryProperties().GetOk("paragraph")
 
 	if !hasSentence || !hasParagraph {
 		return ruleresult.NotRun, "Field not present"
 	}
 
 	if strings.HasPrefix(paragraph, sentence) {
+		reportPropertyLocation(projectData, "library.properties", "paragraph")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesCategoryFieldMissing checks for missing library.properties "category" field.
-func LibraryPropertiesCategoryFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesCategoryFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("category", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("category", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "library.properties", "category")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesCategoryFieldInvalid checks for invalid category in the library.properties "category" field.
-func LibraryPropertiesCategoryFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesCategoryFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	category, ok := projectdata.LibraryProperties().GetOk("category")
+	category, ok := projectData.LibraryProperties().GetOk("category")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyEnumMismatch("category", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyEnumMismatch("category", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "category")
 		return ruleresult.Fail, category
 	}
 
@@ -911,17 +971,18 @@ func LibraryPropertiesCategoryFieldInvalid() (result ruleresult.Type, output str
 }
 
 // LibraryPropertiesCategoryFieldUncategorized checks whether the library.properties "category" value is "Uncategorized".
-func LibraryPropertiesCategoryFieldUncategorized() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesCategoryFieldUncategorized(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	category, ok := projectdata.LibraryProperties().GetOk("category")
+	category, ok := projectData.LibraryProperties().GetOk("category")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
 	if category == "Uncategorized" {
+		reportPropertyLocation(projectData, "library.properties", "category")
 		return ruleresult.Fail, ""
 	}
 
@@ -929,33 +990,35 @@ func LibraryPropertiesCategoryFieldUncategorized() (result ruleresult.Type, outp
 }
 
 // LibraryPropertiesUrlFieldMissing checks for missing library.properties "url" field.
-func LibraryPropertiesUrlFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesUrlFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("url", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("url", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "url")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesUrlFieldInvalid checks whether the library.properties "url" value has a valid URL format.
-func LibraryPropertiesUrlFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesUrlFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	url, ok := projectdata.LibraryProperties().GetOk("url")
+	url, ok := projectData.LibraryProperties().GetOk("url")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
-	if schema.ValidationErrorMatch("^#/url$", "/format$", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.ValidationErrorMatch("^#/url$", "/format$", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "url")
 		return ruleresult.Fail, url
 	}
 
@@ -963,56 +1026,67 @@ func LibraryPropertiesUrlFieldInvalid() (result ruleresult.Type, output string)
 }
 
 // LibraryPropertiesUrlFieldDeadLink checks whether the URL in the library.properties `url` field can be loaded.
-func LibraryPropertiesUrlFieldDeadLink() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesUrlFieldDeadLink(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	url, ok := projectdata.LibraryProperties().GetOk("url")
+	url, ok := projectData.LibraryProperties().GetOk("url")
 	if !ok {
 		return ruleresult.NotRun, "Field not present"
 	}
 
+	if configuration.Offline() {
+		return ruleresult.Skip, "Unable to check URL in offline mode"
+	}
+
 	logrus.Tracef("Checking URL: %s", url)
-	httpResponse, err := http.Get(url)
-	if err != nil {
-		return ruleresult.Fail, err.Error()
+	urlCheckResult := httpcache.CheckURL(configuration.CachePath(), url, urlCheckCacheTimeToLive)
+	if urlCheckResult.Error != "" {
+		reportPropertyLocation(projectData, "library.properties", "url")
+		return ruleresult.Fail, urlCheckResult.Error
 	}
 
-	if httpResponse.StatusCode == http.StatusOK {
+	if urlCheckResult.StatusCode == http.StatusOK {
 		return ruleresult.Pass, ""
 	}
 
-	return ruleresult.Fail, httpResponse.Status
+	reportPropertyLocation(projectData, "library.properties", "url")
+	return ruleresult.Fail, urlCheckResult.Status
 }
 
+// urlCheckCacheTimeToLive is how long the cached result of a URL check is used for.
+const urlCheckCacheTimeToLive = 24 * time.Hour
+
 // LibraryPropertiesArchitecturesFieldMissing checks for missing library.properties "architectures" field.
-func LibraryPropertiesArchitecturesFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesArchitecturesFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
+	if projectData.LoadedLibrary().IsLegacy {
 		return ruleresult.Skip, "Library has legacy format"
 	}
 
-	if schema.RequiredPropertyMissing("architectures", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("architectures", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "library.properties", "architectures")
 		return ruleresult.Fail, ""
 	}
 	return ruleresult.Pass, ""
 }
 
 // LibraryPropertiesArchitecturesFieldLTMinLength checks if the library.properties "architectures" value is less than the minimum length.
-func LibraryPropertiesArchitecturesFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesArchitecturesFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("architectures") {
+	if !projectData.LibraryProperties().ContainsKey("architectures") {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("architectures", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("architectures", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "architectures")
 		return ruleresult.Fail, ""
 	}
 
@@ -1020,12 +1094,12 @@ func LibraryPropertiesArchitecturesFieldLTMinLength() (result ruleresult.Type, o
 }
 
 // LibraryPropertiesArchitecturesFieldSoloAlias checks whether an alias architecture name is present, but not its true Arduino architecture name.
-func LibraryPropertiesArchitecturesFieldSoloAlias() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesArchitecturesFieldSoloAlias(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	architectures, ok := projectdata.LibraryProperties().GetOk("architectures")
+	architectures, ok := projectData.LibraryProperties().GetOk("architectures")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
@@ -1064,6 +1138,7 @@ func LibraryPropertiesArchitecturesFieldSoloAlias() (result ruleresult.Type, out
 	}
 
 	if len(soloAliases) > 0 {
+		reportPropertyLocation(projectData, "library.properties", "architectures")
 		return ruleresult.Fail, strings.Join(soloAliases, ", ")
 	}
 
@@ -1071,39 +1146,18 @@ func LibraryPropertiesArchitecturesFieldSoloAlias() (result ruleresult.Type, out
 }
 
 // LibraryPropertiesArchitecturesFieldValueCase checks for incorrect case of common architectures.
-func LibraryPropertiesArchitecturesFieldValueCase() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesArchitecturesFieldValueCase(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	architectures, ok := projectdata.LibraryProperties().GetOk("architectures")
+	architectures, ok := projectData.LibraryProperties().GetOk("architectures")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
 	architecturesList := commaSeparatedToList(architectures)
 
-	var commonArchitecturesList = []string{
-		"apollo3",
-		"arc32",
-		"avr",
-		"esp32",
-		"esp8266",
-		"i586",
-		"i686",
-		"k210",
-		"mbed",
-		"megaavr",
-		"mraa",
-		"nRF5",
-		"nrf52",
-		"pic32",
-		"sam",
-		"samd",
-		"wiced",
-		"win10",
-	}
-
 	correctArchitecturePresent := func(correctArchitectureQuery string) bool {
 		for _, architecture := range architecturesList {
 			if architecture == correctArchitectureQuery {
@@ -1116,7 +1170,7 @@ func LibraryPropertiesArchitecturesFieldValueCase() (result ruleresult.Type, out
 
 	miscasedArchitectures := []string{}
 	for _, architecture := range architecturesList {
-		for _, commonArchitecture := range commonArchitecturesList {
+		for _, commonArchitecture := range libraryproperties.CommonArchitectures {
 			if architecture == commonArchitecture {
 				break
 			}
@@ -1130,6 +1184,7 @@ func LibraryPropertiesArchitecturesFieldValueCase() (result ruleresult.Type, out
 	}
 
 	if len(miscasedArchitectures) > 0 {
+		reportPropertyLocation(projectData, "library.properties", "architectures")
 		return ruleresult.Fail, strings.Join(miscasedArchitectures, ", ")
 	}
 
@@ -1137,17 +1192,18 @@ func LibraryPropertiesArchitecturesFieldValueCase() (result ruleresult.Type, out
 }
 
 // LibraryPropertiesDependsFieldDisallowedCharacters checks for disallowed characters in the library.properties "depends" field.
-func LibraryPropertiesDependsFieldDisallowedCharacters() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesDependsFieldDisallowedCharacters(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	depends, ok := projectdata.LibraryProperties().GetOk("depends")
+	depends, ok := projectData.LibraryProperties().GetOk("depends")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyPatternMismatch("depends", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyPatternMismatch("depends", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "depends")
 		return ruleresult.Fail, depends
 	}
 
@@ -1155,16 +1211,20 @@ func LibraryPropertiesDependsFieldDisallowedCharacters() (result ruleresult.Type
 }
 
 // LibraryPropertiesDependsFieldNotInIndex checks whether the libraries listed in the library.properties `depends` field are in the Library Manager index.
-func LibraryPropertiesDependsFieldNotInIndex() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesDependsFieldNotInIndex(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	depends, hasDepends := projectdata.LibraryProperties().GetOk("depends")
+	depends, hasDepends := projectData.LibraryProperties().GetOk("depends")
 	if !hasDepends {
 		return ruleresult.Skip, "Field not present"
 	}
 
+	if projectData.LibraryManagerIndexLoadError() != nil {
+		return ruleresult.Skip, projectData.LibraryManagerIndexLoadError().Error()
+	}
+
 	dependencies := commaSeparatedToList(depends)
 
 	dependenciesNotInIndex := []string{}
@@ -1173,12 +1233,13 @@ func LibraryPropertiesDependsFieldNotInIndex() (result ruleresult.Type, output s
 			continue
 		}
 		logrus.Tracef("Checking if dependency %s is in index.", dependency)
-		if !nameInLibraryManagerIndex(dependency) {
+		if !nameInLibraryManagerIndex(projectData, dependency) {
 			dependenciesNotInIndex = append(dependenciesNotInIndex, dependency)
 		}
 	}
 
 	if len(dependenciesNotInIndex) > 0 {
+		reportPropertyLocation(projectData, "library.properties", "depends")
 		return ruleresult.Fail, strings.Join(dependenciesNotInIndex, ", ")
 	}
 
@@ -1186,17 +1247,18 @@ func LibraryPropertiesDependsFieldNotInIndex() (result ruleresult.Type, output s
 }
 
 // LibraryPropertiesDotALinkageFieldInvalid checks for invalid value in the library.properties "dot_a_linkage" field.
-func LibraryPropertiesDotALinkageFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesDotALinkageFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load library.properties"
 	}
 
-	dotALinkage, ok := projectdata.LibraryProperties().GetOk("dot_a_linkage")
+	dotALinkage, ok := projectData.LibraryProperties().GetOk("dot_a_linkage")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyEnumMismatch("dot_a_linkage", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyEnumMismatch("dot_a_linkage", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "dot_a_linkage")
 		return ruleresult.Fail, dotALinkage
 	}
 
@@ -1204,16 +1266,17 @@ func LibraryPropertiesDotALinkageFieldInvalid() (result ruleresult.Type, output
 }
 
 // LibraryPropertiesDotALinkageFieldTrueWithFlatLayout checks whether a library using the "dot_a_linkage" feature has the required recursive layout type.
-func LibraryPropertiesDotALinkageFieldTrueWithFlatLayout() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil {
+func LibraryPropertiesDotALinkageFieldTrueWithFlatLayout(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LoadedLibrary() == nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("dot_a_linkage") {
+	if !projectData.LibraryProperties().ContainsKey("dot_a_linkage") {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if projectdata.LoadedLibrary().DotALinkage && projectdata.LoadedLibrary().Layout == libraries.FlatLayout {
+	if projectData.LoadedLibrary().DotALinkage && projectData.LoadedLibrary().Layout == libraries.FlatLayout {
+		reportPropertyLocation(projectData, "library.properties", "dot_a_linkage")
 		return ruleresult.Fail, ""
 	}
 
@@ -1221,16 +1284,17 @@ func LibraryPropertiesDotALinkageFieldTrueWithFlatLayout() (result ruleresult.Ty
 }
 
 // LibraryPropertiesIncludesFieldLTMinLength checks if the library.properties "includes" value is less than the minimum length.
-func LibraryPropertiesIncludesFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesIncludesFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("includes") {
+	if !projectData.LibraryProperties().ContainsKey("includes") {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("includes", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("includes", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "includes")
 		return ruleresult.Fail, ""
 	}
 
@@ -1238,12 +1302,12 @@ func LibraryPropertiesIncludesFieldLTMinLength() (result ruleresult.Type, output
 }
 
 // LibraryPropertiesIncludesFieldItemNotFound checks whether the header files specified in the library.properties `includes` field are in the library.
-func LibraryPropertiesIncludesFieldItemNotFound() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesIncludesFieldItemNotFound(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	includes, ok := projectdata.LibraryProperties().GetOk("includes")
+	includes, ok := projectData.LibraryProperties().GetOk("includes")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
@@ -1254,7 +1318,7 @@ func LibraryPropertiesIncludesFieldItemNotFound() (result ruleresult.Type, outpu
 		if include == "" {
 			return true
 		}
-		for _, header := range projectdata.SourceHeaders() {
+		for _, header := range projectData.SourceHeaders() {
 			logrus.Tracef("Comparing include %s with header file %s", include, header)
 			if include == header {
 				logrus.Tracef("match!")
@@ -1272,6 +1336,7 @@ func LibraryPropertiesIncludesFieldItemNotFound() (result ruleresult.Type, outpu
 	}
 
 	if len(includesNotInLibrary) > 0 {
+		reportPropertyLocation(projectData, "library.properties", "includes")
 		return ruleresult.Fail, strings.Join(includesNotInLibrary, ", ")
 	}
 
@@ -1279,17 +1344,18 @@ func LibraryPropertiesIncludesFieldItemNotFound() (result ruleresult.Type, outpu
 }
 
 // LibraryPropertiesPrecompiledFieldInvalid checks for invalid value in the library.properties "precompiled" field.
-func LibraryPropertiesPrecompiledFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesPrecompiledFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	precompiled, ok := projectdata.LibraryProperties().GetOk("precompiled")
+	precompiled, ok := projectData.LibraryProperties().GetOk("precompiled")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyEnumMismatch("precompiled", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyEnumMismatch("precompiled", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "precompiled")
 		return ruleresult.Fail, precompiled
 	}
 
@@ -1297,17 +1363,18 @@ func LibraryPropertiesPrecompiledFieldInvalid() (result ruleresult.Type, output
 }
 
 // LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout checks whether a precompiled library has the required recursive layout type.
-func LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil || projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LoadedLibrary() == nil || projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	precompiled, ok := projectdata.LibraryProperties().GetOk("precompiled")
+	precompiled, ok := projectData.LibraryProperties().GetOk("precompiled")
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if projectdata.LoadedLibrary().Precompiled && projectdata.LoadedLibrary().Layout == libraries.FlatLayout {
+	if projectData.LoadedLibrary().Precompiled && projectData.LoadedLibrary().Layout == libraries.FlatLayout {
+		reportPropertyLocation(projectData, "library.properties", "precompiled")
 		return ruleresult.Fail, precompiled
 	}
 
@@ -1315,16 +1382,17 @@ func LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout() (result ruleresult
 }
 
 // LibraryPropertiesLdflagsFieldLTMinLength checks if the library.properties "ldflags" value is less than the minimum length.
-func LibraryPropertiesLdflagsFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesLdflagsFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("ldflags") {
+	if !projectData.LibraryProperties().ContainsKey("ldflags") {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	if schema.PropertyLessThanMinLength("ldflags", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("ldflags", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "library.properties", "ldflags")
 		return ruleresult.Fail, ""
 	}
 
@@ -1332,12 +1400,18 @@ func LibraryPropertiesLdflagsFieldLTMinLength() (result ruleresult.Type, output
 }
 
 // LibraryPropertiesMisspelledOptionalField checks if library.properties contains common misspellings of optional fields.
-func LibraryPropertiesMisspelledOptionalField() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func LibraryPropertiesMisspelledOptionalField(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	if schema.MisspelledOptionalPropertyFound(projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+	validationResult := projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]
+	if schema.MisspelledOptionalPropertyFound(validationResult) {
+		for _, key := range projectData.LibraryProperties().Keys() {
+			if schema.ValidationErrorMatch("^#/"+regexp.QuoteMeta(key)+"$", "/misspelledOptionalProperties/", "", "", validationResult) {
+				reportPropertyLocation(projectData, "library.properties", key)
+			}
+		}
 		return ruleresult.Fail, ""
 	}
 
@@ -1345,14 +1419,15 @@ func LibraryPropertiesMisspelledOptionalField() (result ruleresult.Type, output
 }
 
 // LibraryHasStraySketches checks for sketches outside the `examples` and `extras` folders.
-func LibraryHasStraySketches() (result ruleresult.Type, output string) {
+func LibraryHasStraySketches(projectData *projectdata.Type) (result ruleresult.Type, output string) {
 	straySketchPaths := []string{}
-	if sketch.ContainsMainSketchFile(projectdata.ProjectPath()) { // Check library root.
-		straySketchPaths = append(straySketchPaths, projectdata.ProjectPath().String())
+	if sketch.ContainsMainSketchFile(projectData.ProjectPath()) { // Check library root.
+		straySketchPaths = append(straySketchPaths, projectData.ProjectPath().String())
+		reportPathLocation(projectData, projectData.ProjectPath())
 	}
 
 	// Check subfolders.
-	projectPathListing, err := projectdata.ProjectPath().ReadDir()
+	projectPathListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -1372,6 +1447,7 @@ func LibraryHasStraySketches() (result ruleresult.Type, output string) {
 		for _, subfolder := range topLevelSubfolderRecursiveListing {
 			if sketch.ContainsMainSketchFile(subfolder) {
 				straySketchPaths = append(straySketchPaths, subfolder.String())
+				reportPathLocation(projectData, subfolder)
 			}
 		}
 	}
@@ -1384,9 +1460,9 @@ func LibraryHasStraySketches() (result ruleresult.Type, output string) {
 }
 
 // MissingExamples checks whether the library is missing examples.
-func MissingExamples() (result ruleresult.Type, output string) {
+func MissingExamples(projectData *projectdata.Type) (result ruleresult.Type, output string) {
 	for _, examplesFolderName := range library.ExamplesFolderSupportedNames() {
-		examplesPath := projectdata.ProjectPath().Join(examplesFolderName)
+		examplesPath := projectData.ProjectPath().Join(examplesFolderName)
 		exists, err := examplesPath.IsDirCheck()
 		if err != nil {
 			panic(err)
@@ -1402,12 +1478,13 @@ func MissingExamples() (result ruleresult.Type, output string) {
 		}
 	}
 
+	reportPathLocation(projectData, projectData.ProjectPath())
 	return ruleresult.Fail, ""
 }
 
 // MisspelledExamplesFolderName checks for incorrectly spelled `examples` folder name.
-func MisspelledExamplesFolderName() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func MisspelledExamplesFolderName(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -1415,6 +1492,7 @@ func MisspelledExamplesFolderName() (result ruleresult.Type, output string) {
 
 	path, found := containsMisspelledPathBaseName(directoryListing, "examples", "(?i)^e((x)|(xs)|(s))((am)|(ma))p((le)|(el))s?$")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -1422,8 +1500,8 @@ func MisspelledExamplesFolderName() (result ruleresult.Type, output string) {
 }
 
 // IncorrectExamplesFolderNameCase checks for incorrect `examples` folder name case.
-func IncorrectExamplesFolderNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectExamplesFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -1431,6 +1509,7 @@ func IncorrectExamplesFolderNameCase() (result ruleresult.Type, output string) {
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "examples")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -1438,8 +1517,8 @@ func IncorrectExamplesFolderNameCase() (result ruleresult.Type, output string) {
 }
 
 // nameInLibraryManagerIndex returns whether there is a library in Library Manager index using the given name.
-func nameInLibraryManagerIndex(name string) bool {
-	libraries := projectdata.LibraryManagerIndex()["libraries"].([]interface{})
+func nameInLibraryManagerIndex(projectData *projectdata.Type, name string) bool {
+	libraries := projectData.LibraryManagerIndex()["libraries"].([]interface{})
 	for _, libraryInterface := range libraries {
 		library := libraryInterface.(map[string]interface{})
 		if library["name"].(string) == name {
@@ -1451,18 +1530,19 @@ func nameInLibraryManagerIndex(name string) bool {
 }
 
 // spellCheckLibraryPropertiesFieldValue returns the value of the provided library.properties field with commonly misspelled words corrected.
-func spellCheckLibraryPropertiesFieldValue(fieldName string) (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
+func spellCheckLibraryPropertiesFieldValue(projectData *projectdata.Type, fieldName string) (result ruleresult.Type, output string) {
+	if projectData.LibraryPropertiesLoadError() != nil {
 		return ruleresult.NotRun, "Library not loaded"
 	}
 
-	fieldValue, ok := projectdata.LibraryProperties().GetOk(fieldName)
+	fieldValue, ok := projectData.LibraryProperties().GetOk(fieldName)
 	if !ok {
 		return ruleresult.Skip, "Field not present"
 	}
 
-	replaced, diff := projectdata.MisspelledWordsReplacer().Replace(fieldValue)
+	replaced, diff := projectData.MisspelledWordsReplacer().Replace(fieldValue)
 	if len(diff) > 0 {
+		reportPropertyLocation(projectData, "library.properties", fieldName)
 		return ruleresult.Fail, replaced
 	}
 
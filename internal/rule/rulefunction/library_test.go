@@ -57,9 +57,9 @@ func checkLibraryRuleFunction(ruleFunction Type, testTables []libraryRuleFunctio
 			SuperprojectType: projecttype.Library,
 		}
 
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject)
 
-		result, output := ruleFunction()
+		result, output := ruleFunction(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 	}
@@ -364,6 +364,14 @@ func TestLibraryPropertiesNameFieldDuplicate(t *testing.T) {
 	}
 
 	checkLibraryRuleFunction(LibraryPropertiesNameFieldDuplicate, testTables, t)
+
+	configureLibraryIndex("", true)
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+	testTables = []libraryRuleFunctionTestTable{
+		{"Index not available offline", "Indexed", ruleresult.Skip, "offline mode"},
+	}
+
+	checkLibraryRuleFunction(LibraryPropertiesNameFieldDuplicate, testTables, t)
 }
 
 func TestLibraryPropertiesNameFieldNotInIndex(t *testing.T) {
@@ -375,6 +383,14 @@ func TestLibraryPropertiesNameFieldNotInIndex(t *testing.T) {
 	}
 
 	checkLibraryRuleFunction(LibraryPropertiesNameFieldNotInIndex, testTables, t)
+
+	configureLibraryIndex("", true)
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+	testTables = []libraryRuleFunctionTestTable{
+		{"Index not available offline", "Indexed", ruleresult.Skip, "offline mode"},
+	}
+
+	checkLibraryRuleFunction(LibraryPropertiesNameFieldNotInIndex, testTables, t)
 }
 
 func TestLibraryPropertiesVersionFieldMissing(t *testing.T) {
@@ -735,6 +751,14 @@ func TestLibraryPropertiesUrlFieldDeadLink(t *testing.T) {
 	}
 
 	checkLibraryRuleFunction(LibraryPropertiesUrlFieldDeadLink, testTables, t)
+
+	configureLibraryIndex(testLibraryIndexPath.String(), true)
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+	testTables = []libraryRuleFunctionTestTable{
+		{"Offline", "Recursive", ruleresult.Skip, "offline mode"},
+	}
+
+	checkLibraryRuleFunction(LibraryPropertiesUrlFieldDeadLink, testTables, t)
 }
 
 func TestLibraryPropertiesArchitecturesFieldMissing(t *testing.T) {
@@ -804,6 +828,14 @@ func TestLibraryPropertiesDependsFieldNotInIndex(t *testing.T) {
 	}
 
 	checkLibraryRuleFunction(LibraryPropertiesDependsFieldNotInIndex, testTables, t)
+
+	configureLibraryIndex("", true)
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+	testTables = []libraryRuleFunctionTestTable{
+		{"Index not available offline", "DependsIndexed", ruleresult.Skip, "offline mode"},
+	}
+
+	checkLibraryRuleFunction(LibraryPropertiesDependsFieldNotInIndex, testTables, t)
 }
 
 func TestLibraryPropertiesDotALinkageFieldInvalid(t *testing.T) {
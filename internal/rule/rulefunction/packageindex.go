@@ -16,15 +16,33 @@
 package rulefunction
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/rule/schema"
+	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
 )
 
 // The rule functions for package indexes.
 
 // PackageIndexMissing checks whether a file resembling a package index was found in the specified project folder.
-func PackageIndexMissing() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
+func PackageIndexMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
 		return ruleresult.Fail, ""
 	}
 
@@ -32,27 +50,904 @@ func PackageIndexMissing() (result ruleresult.Type, output string) {
 }
 
 // PackageIndexJSONFormat checks whether the package index file is a valid JSON document.
-func PackageIndexJSONFormat() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
+func PackageIndexJSONFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
 		return ruleresult.NotRun, "Package index not found"
 	}
 
-	if isValidJSON(projectdata.ProjectPath()) {
+	if isValidJSON(projectData.ProjectPath()) {
 		return ruleresult.Pass, ""
 	}
 
+	reportPathLocation(projectData, projectData.ProjectPath())
 	return ruleresult.Fail, ""
 }
 
 // PackageIndexFormat checks for invalid package index data format.
-func PackageIndexFormat() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
+func PackageIndexFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		reportPathLocation(projectData, projectData.ProjectPath())
+		return ruleresult.Fail, projectData.PackageIndexLoadError().Error()
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesMaintainerMissing checks for packages missing the maintainer property.
+func PackageIndexPackagesMaintainerMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPackages()) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantIDs := packageIndexDataMissingRequiredProperty(projectData, projectData.PackageIndexPackages(), "maintainer", compliancelevel.Specification)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesMaintainerLTMinLength checks for packages with maintainer property less than the minimum length.
+func PackageIndexPackagesMaintainerLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPackages()) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantIDs := packageIndexDataValueLTMinLength(projectData, projectData.PackageIndexPackages(), "maintainer", compliancelevel.Specification)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesWebsiteURLMissing checks for packages missing the websiteURL property.
+func PackageIndexPackagesWebsiteURLMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPackages()) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantIDs := packageIndexDataMissingRequiredProperty(projectData, projectData.PackageIndexPackages(), "websiteURL", compliancelevel.Strict)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesHelpOnlineMissing checks for packages missing the help.online property.
+func PackageIndexPackagesHelpOnlineMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPackages()) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantIDs := packageIndexDataMissingRequiredProperty(projectData, projectData.PackageIndexPackages(), "help", compliancelevel.Strict)
+	nonCompliantIDs = append(nonCompliantIDs, packageIndexDataMissingRequiredProperty(projectData, projectData.PackageIndexPackages(), "help/online", compliancelevel.Strict)...)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesPlatformsArchitectureMissing checks for platforms missing the architecture property.
+func PackageIndexPackagesPlatformsArchitectureMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPlatforms()) == 0 {
+		return ruleresult.Skip, "Package index has no platforms"
+	}
+
+	nonCompliantIDs := packageIndexDataMissingRequiredProperty(projectData, projectData.PackageIndexPlatforms(), "architecture", compliancelevel.Specification)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesPlatformsArchitectureInvalid checks for platforms with an invalid architecture property.
+func PackageIndexPackagesPlatformsArchitectureInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPlatforms()) == 0 {
+		return ruleresult.Skip, "Package index has no platforms"
+	}
+
+	nonCompliantIDs := packageIndexDataValuePatternMismatch(projectData, projectData.PackageIndexPlatforms(), "architecture", compliancelevel.Specification)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesPlatformsCategoryMissing checks for platforms missing the category property.
+func PackageIndexPackagesPlatformsCategoryMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPlatforms()) == 0 {
+		return ruleresult.Skip, "Package index has no platforms"
+	}
+
+	nonCompliantIDs := packageIndexDataMissingRequiredProperty(projectData, projectData.PackageIndexPlatforms(), "category", compliancelevel.Specification)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesPlatformsCategoryInvalid checks for platforms with an invalid category property.
+func PackageIndexPackagesPlatformsCategoryInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPlatforms()) == 0 {
+		return ruleresult.Skip, "Package index has no platforms"
+	}
+
+	nonCompliantIDs := packageIndexDataValueEnumMismatch(projectData, projectData.PackageIndexPlatforms(), "category", compliancelevel.Specification)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexPackagesPlatformsBoardsEmpty checks for platforms with no boards listed.
+func PackageIndexPackagesPlatformsBoardsEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPlatforms()) == 0 {
+		return ruleresult.Skip, "Package index has no platforms"
+	}
+
+	nonCompliantIDs := packageIndexDataMissingRequiredProperty(projectData, projectData.PackageIndexPlatforms(), "boards", compliancelevel.Strict)
+	nonCompliantIDs = append(nonCompliantIDs, packageIndexDataValueLTMinItems(projectData, projectData.PackageIndexPlatforms(), "boards", compliancelevel.Strict)...)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexChecksumInvalidFormat checks for release archives with an invalid checksum format.
+func PackageIndexChecksumInvalidFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	archives := packageIndexArchives(projectData)
+	if len(archives) == 0 {
+		return ruleresult.Skip, "Package index has no release archives"
+	}
+
+	nonCompliantIDs := []string{}
+	for _, archive := range archives {
+		if !checksumRegexp.MatchString(archive.checksum) {
+			nonCompliantIDs = append(nonCompliantIDs, archive.id)
+			reportPackageIndexLocation(projectData, archive.jsonPointer+"/checksum")
+		}
+	}
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexChecksumMismatch checks whether the checksums of the release archives in the archives folder match the package index.
+func PackageIndexChecksumMismatch(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if configuration.ArchivesPath() == nil {
+		return ruleresult.Skip, "Archives folder not specified"
+	}
+
+	nonCompliantIDs := []string{}
+	unverifiedIDs := []string{}
+	for _, archive := range packageIndexArchives(projectData) {
+		if !checksumRegexp.MatchString(archive.checksum) {
+			continue // Only the archives that have a valid checksum format can be verified.
+		}
+		archivePath := archive.localPath()
+		if archivePath == nil {
+			unverifiedIDs = append(unverifiedIDs, archive.id)
+			continue
+		}
+
+		checksumParts := strings.SplitN(archive.checksum, ":", 2)
+		digest, err := fileDigest(archivePath, checksumParts[0])
+		if err != nil {
+			return ruleresult.NotRun, fmt.Sprintf("Error reading %s: %v", archivePath, err)
+		}
+		if !strings.EqualFold(digest, checksumParts[1]) {
+			nonCompliantIDs = append(nonCompliantIDs, archive.id)
+			reportPackageIndexLocation(projectData, archive.jsonPointer+"/checksum")
+		}
+	}
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	if len(unverifiedIDs) > 0 {
+		return ruleresult.Skip, "Archives not found in the archives folder: " + strings.Join(unverifiedIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexSizeMismatch checks whether the sizes of the release archives in the archives folder match the package index.
+func PackageIndexSizeMismatch(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if configuration.ArchivesPath() == nil {
+		return ruleresult.Skip, "Archives folder not specified"
+	}
+
+	nonCompliantIDs := []string{}
+	unverifiedIDs := []string{}
+	for _, archive := range packageIndexArchives(projectData) {
+		archivePath := archive.localPath()
+		if archivePath == nil {
+			unverifiedIDs = append(unverifiedIDs, archive.id)
+			continue
+		}
+
+		archiveInfo, err := archivePath.Stat()
+		if err != nil {
+			return ruleresult.NotRun, fmt.Sprintf("Error reading %s: %v", archivePath, err)
+		}
+		if archive.size != fmt.Sprint(archiveInfo.Size()) {
+			nonCompliantIDs = append(nonCompliantIDs, archive.id)
+			reportPackageIndexLocation(projectData, archive.jsonPointer+"/size")
+		}
+	}
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	if len(unverifiedIDs) > 0 {
+		return ruleresult.Skip, "Archives not found in the archives folder: " + strings.Join(unverifiedIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexToolsDependenciesUnresolved checks for platform tool dependencies not provided by the package index.
+func PackageIndexToolsDependenciesUnresolved(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndex().Packages) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantDependencies := []string{}
+	for packageIndex, packageData := range projectData.PackageIndex().Packages {
+		for platformIndex, platformData := range packageData.Platforms {
+			for dependencyIndex, dependency := range platformData.ToolDependencies {
+				toolPackageFound := false
+				dependencyResolved := false
+				for _, toolPackage := range projectData.PackageIndex().Packages {
+					if toolPackage.Name != dependency.Packager {
+						continue
+					}
+					toolPackageFound = true
+					for _, toolData := range toolPackage.Tools {
+						if toolData.Name == dependency.Name && fmt.Sprint(toolData.Version) == fmt.Sprint(dependency.Version) {
+							dependencyResolved = true
+						}
+					}
+				}
+
+				// The tools of packages not in this index are provided by other package indexes, so can't be checked.
+				if toolPackageFound && !dependencyResolved {
+					nonCompliantDependencies = append(
+						nonCompliantDependencies,
+						fmt.Sprintf("%s:%s@%s depends on %s:%s@%s", packageData.Name, platformData.Architecture, platformData.Version, dependency.Packager, dependency.Name, dependency.Version),
+					)
+					reportPackageIndexLocation(projectData, fmt.Sprintf("/packages/%d/platforms/%d/toolsDependencies/%d", packageIndex, platformIndex, dependencyIndex))
+				}
+			}
+		}
+	}
+
+	if len(nonCompliantDependencies) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantDependencies, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexToolsSystemsMissing checks for tool releases that don't provide a system for each of the standard hosts.
+func PackageIndexToolsSystemsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	nonCompliantTools := []string{}
+	toolCount := 0
+	for packageIndex, packageData := range projectData.PackageIndex().Packages {
+		for toolIndex, toolData := range packageData.Tools {
+			toolCount++
+			missingHosts := []string{}
+			for _, standardHost := range standardToolHosts {
+				hostProvided := false
+				for _, systemData := range toolData.Systems {
+					if systemData.OS == "all" || standardHost.hostRegexp.MatchString(systemData.OS) {
+						hostProvided = true
+						break
+					}
+				}
+				if !hostProvided {
+					missingHosts = append(missingHosts, standardHost.host)
+				}
+			}
+
+			if len(missingHosts) > 0 {
+				nonCompliantTools = append(nonCompliantTools, fmt.Sprintf("%s:%s@%s (%s)", packageData.Name, toolData.Name, toolData.Version, strings.Join(missingHosts, ", ")))
+				reportPackageIndexLocation(projectData, fmt.Sprintf("/packages/%d/tools/%d/systems", packageIndex, toolIndex))
+			}
+		}
+	}
+
+	if toolCount == 0 {
+		return ruleresult.Skip, "Package index has no tools"
+	}
+
+	if len(nonCompliantTools) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantTools, "; ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexReleaseVersionDuplicate checks for platform or tool releases with the same version as another release.
+func PackageIndexReleaseVersionDuplicate(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
 		return ruleresult.NotRun, "Package index not found"
 	}
 
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.Fail, projectdata.PackageIndexLoadError().Error()
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndex().Packages) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantIDs := []string{}
+	for packageIndex, packageData := range projectData.PackageIndex().Packages {
+		for platformIndex, platformData := range packageData.Platforms {
+			for _, previousPlatformData := range packageData.Platforms[:platformIndex] {
+				if previousPlatformData.Architecture == platformData.Architecture &&
+					platformData.Version != nil && previousPlatformData.Version != nil &&
+					platformData.Version.Equal(previousPlatformData.Version) {
+					nonCompliantIDs = append(nonCompliantIDs, fmt.Sprintf("%s:%s@%s", packageData.Name, platformData.Architecture, platformData.Version))
+					reportPackageIndexLocation(projectData, fmt.Sprintf("/packages/%d/platforms/%d/version", packageIndex, platformIndex))
+					break
+				}
+			}
+		}
+
+		for toolIndex, toolData := range packageData.Tools {
+			for _, previousToolData := range packageData.Tools[:toolIndex] {
+				if previousToolData.Name == toolData.Name &&
+					toolData.Version != nil && previousToolData.Version != nil &&
+					toolData.Version.Equal(previousToolData.Version) {
+					nonCompliantIDs = append(nonCompliantIDs, fmt.Sprintf("%s:%s@%s", packageData.Name, toolData.Name, toolData.Version))
+					reportPackageIndexLocation(projectData, fmt.Sprintf("/packages/%d/tools/%d/version", packageIndex, toolIndex))
+					break
+				}
+			}
+		}
+	}
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
 	}
 
 	return ruleresult.Pass, ""
 }
+
+// PackageIndexReleaseVersionNonSemver checks for platform or tool releases with a version that is not semver compliant.
+func PackageIndexReleaseVersionNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexPropertiesLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndexPlatforms())+len(projectData.PackageIndexTools()) == 0 {
+		return ruleresult.Skip, "Package index has no releases"
+	}
+
+	nonCompliantIDs := packageIndexDataValuePatternMismatch(projectData, projectData.PackageIndexPlatforms(), "version", compliancelevel.Strict)
+	nonCompliantIDs = append(nonCompliantIDs, packageIndexDataValuePatternMismatch(projectData, projectData.PackageIndexTools(), "version", compliancelevel.Strict)...)
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexReleaseVersionOrder checks for platform or tool releases which break the version order of the other
+// releases.
+func PackageIndexReleaseVersionOrder(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndex().Packages) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantIDs := []string{}
+	for packageIndex, packageData := range projectData.PackageIndex().Packages {
+		platformReleases := make(map[string][]releaseVersionType)
+		architectures := []string{}
+		for platformIndex, platformData := range packageData.Platforms {
+			if platformData.Version == nil {
+				continue
+			}
+			if _, ok := platformReleases[platformData.Architecture]; !ok {
+				architectures = append(architectures, platformData.Architecture)
+			}
+			platformReleases[platformData.Architecture] = append(platformReleases[platformData.Architecture], releaseVersionType{
+				id:          fmt.Sprintf("%s:%s@%s", packageData.Name, platformData.Architecture, platformData.Version),
+				version:     semver.ParseRelaxed(platformData.Version.String()),
+				jsonPointer: fmt.Sprintf("/packages/%d/platforms/%d/version", packageIndex, platformIndex),
+			})
+		}
+		for _, architecture := range architectures {
+			for _, release := range releasesOutOfOrder(platformReleases[architecture]) {
+				nonCompliantIDs = append(nonCompliantIDs, release.id)
+				reportPackageIndexLocation(projectData, release.jsonPointer)
+			}
+		}
+
+		toolReleases := make(map[string][]releaseVersionType)
+		toolNames := []string{}
+		for toolIndex, toolData := range packageData.Tools {
+			if toolData.Version == nil {
+				continue
+			}
+			if _, ok := toolReleases[toolData.Name]; !ok {
+				toolNames = append(toolNames, toolData.Name)
+			}
+			toolReleases[toolData.Name] = append(toolReleases[toolData.Name], releaseVersionType{
+				id:          fmt.Sprintf("%s:%s@%s", packageData.Name, toolData.Name, toolData.Version),
+				version:     toolData.Version,
+				jsonPointer: fmt.Sprintf("/packages/%d/tools/%d/version", packageIndex, toolIndex),
+			})
+		}
+		for _, toolName := range toolNames {
+			for _, release := range releasesOutOfOrder(toolReleases[toolName]) {
+				nonCompliantIDs = append(nonCompliantIDs, release.id)
+				reportPackageIndexLocation(projectData, release.jsonPointer)
+			}
+		}
+	}
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// releaseVersionType is the type for the version of a release of a platform or tool.
+type releaseVersionType struct {
+	id          string
+	version     *semver.RelaxedVersion
+	jsonPointer string
+}
+
+// releasesOutOfOrder returns the releases which break the version order of the list. Releases may be listed from oldest
+// to newest or from newest to oldest, so the order is the direction most of the pairs of consecutive releases follow,
+// with the direction of the first pair deciding ties.
+func releasesOutOfOrder(releases []releaseVersionType) []releaseVersionType {
+	ascendingPairCount := 0
+	descendingPairCount := 0
+	firstDirection := 0
+	for releaseIndex := 1; releaseIndex < len(releases); releaseIndex++ {
+		direction := releases[releaseIndex].version.CompareTo(releases[releaseIndex-1].version)
+		if direction > 0 {
+			ascendingPairCount++
+		} else if direction < 0 {
+			descendingPairCount++
+		}
+		if firstDirection == 0 {
+			firstDirection = direction
+		}
+	}
+	descending := descendingPairCount > ascendingPairCount || (descendingPairCount == ascendingPairCount && firstDirection < 0)
+
+	outOfOrderReleases := []releaseVersionType{}
+	var extremeVersion *semver.RelaxedVersion // The highest version so far if ascending, or the lowest if descending.
+	for _, release := range releases {
+		if extremeVersion != nil && ((!descending && release.version.LessThan(extremeVersion)) || (descending && release.version.GreaterThan(extremeVersion))) {
+			outOfOrderReleases = append(outOfOrderReleases, release)
+		} else {
+			extremeVersion = release.version
+		}
+	}
+
+	return outOfOrderReleases
+}
+
+// PackageIndexPlatformNameCategoryChanged checks for platform releases with a different name or category than the previous release of the platform.
+func PackageIndexPlatformNameCategoryChanged(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	if len(projectData.PackageIndex().Packages) == 0 {
+		return ruleresult.Skip, "Package index has no packages"
+	}
+
+	nonCompliantIDs := []string{}
+	for packageIndex, packageData := range projectData.PackageIndex().Packages {
+		previousPlatformIndexes := make(map[string]int)
+		for platformIndex, platformData := range packageData.Platforms {
+			previousPlatformIndex, ok := previousPlatformIndexes[platformData.Architecture]
+			previousPlatformIndexes[platformData.Architecture] = platformIndex
+			if !ok {
+				continue
+			}
+
+			previousPlatformData := packageData.Platforms[previousPlatformIndex]
+			if platformData.Name != previousPlatformData.Name {
+				reportPackageIndexLocation(projectData, fmt.Sprintf("/packages/%d/platforms/%d/name", packageIndex, platformIndex))
+			}
+			if platformData.Category != previousPlatformData.Category {
+				reportPackageIndexLocation(projectData, fmt.Sprintf("/packages/%d/platforms/%d/category", packageIndex, platformIndex))
+			}
+			if platformData.Name != previousPlatformData.Name || platformData.Category != previousPlatformData.Category {
+				nonCompliantIDs = append(nonCompliantIDs, fmt.Sprintf("%s:%s@%s", packageData.Name, platformData.Architecture, platformData.Version))
+			}
+		}
+	}
+
+	if len(nonCompliantIDs) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PackageIndexArchiveFileNameCollision checks for different release archives with the same file name.
+func PackageIndexArchiveFileNameCollision(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found"
+	}
+
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index"
+	}
+
+	archives := packageIndexArchives(projectData)
+	if len(archives) == 0 {
+		return ruleresult.Skip, "Package index has no release archives"
+	}
+
+	nonCompliantArchives := []string{}
+	firstArchives := make(map[string]packageIndexArchive)
+	for _, archive := range archives {
+		firstArchive, ok := firstArchives[archive.archiveFileName]
+		if !ok {
+			firstArchives[archive.archiveFileName] = archive
+			continue
+		}
+
+		// Systems of a tool release often share an archive, which is not a collision.
+		if !strings.EqualFold(archive.checksum, firstArchive.checksum) {
+			nonCompliantArchives = append(nonCompliantArchives, fmt.Sprintf("%s and %s (%s)", firstArchive.id, archive.id, archive.archiveFileName))
+			reportPackageIndexLocation(projectData, archive.jsonPointer+"/archiveFileName")
+		}
+	}
+
+	if len(nonCompliantArchives) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantArchives, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// packageIndexDataMissingRequiredProperty returns the IDs of the given package index data items missing the given required property.
+func packageIndexDataMissingRequiredProperty(projectData *projectdata.Type, items []projectdata.PackageIndexData, propertyName string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantIDs := []string{}
+	for _, item := range items {
+		if schema.RequiredPropertyMissing(packageIndexPropertyQuery(item, propertyName), projectData.PackageIndexSchemaValidationResult()[complianceLevel]) {
+			nonCompliantIDs = append(nonCompliantIDs, item.ID)
+			reportPackageIndexLocation(projectData, item.JSONPointer+"/"+propertyName)
+		}
+	}
+
+	return nonCompliantIDs
+}
+
+// packageIndexDataValueLTMinLength returns the IDs of the given package index data items with value of the given property less than the minimum length.
+func packageIndexDataValueLTMinLength(projectData *projectdata.Type, items []projectdata.PackageIndexData, propertyName string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantIDs := []string{}
+	for _, item := range items {
+		if schema.PropertyLessThanMinLength(packageIndexPropertyQuery(item, propertyName), projectData.PackageIndexSchemaValidationResult()[complianceLevel]) {
+			nonCompliantIDs = append(nonCompliantIDs, item.ID)
+			reportPackageIndexLocation(projectData, item.JSONPointer+"/"+propertyName)
+		}
+	}
+
+	return nonCompliantIDs
+}
+
+// packageIndexDataValueLTMinItems returns the IDs of the given package index data items with value of the given array property having less than the minimum number of items.
+func packageIndexDataValueLTMinItems(projectData *projectdata.Type, items []projectdata.PackageIndexData, propertyName string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantIDs := []string{}
+	for _, item := range items {
+		if schema.ValidationErrorMatch("^#/"+packageIndexPropertyQuery(item, propertyName)+"$", "/minItems$", "", "", projectData.PackageIndexSchemaValidationResult()[complianceLevel]) {
+			nonCompliantIDs = append(nonCompliantIDs, item.ID)
+			reportPackageIndexLocation(projectData, item.JSONPointer+"/"+propertyName)
+		}
+	}
+
+	return nonCompliantIDs
+}
+
+// packageIndexDataValuePatternMismatch returns the IDs of the given package index data items with value of the given property not matching the JSON schema pattern.
+func packageIndexDataValuePatternMismatch(projectData *projectdata.Type, items []projectdata.PackageIndexData, propertyName string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantIDs := []string{}
+	for _, item := range items {
+		if schema.PropertyPatternMismatch(packageIndexPropertyQuery(item, propertyName)+"$", projectData.PackageIndexSchemaValidationResult()[complianceLevel]) {
+			nonCompliantIDs = append(nonCompliantIDs, item.ID)
+			reportPackageIndexLocation(projectData, item.JSONPointer+"/"+propertyName)
+		}
+	}
+
+	return nonCompliantIDs
+}
+
+// packageIndexDataValueEnumMismatch returns the IDs of the given package index data items with value of the given property not matching the JSON schema enum.
+func packageIndexDataValueEnumMismatch(projectData *projectdata.Type, items []projectdata.PackageIndexData, propertyName string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantIDs := []string{}
+	for _, item := range items {
+		if schema.PropertyEnumMismatch(packageIndexPropertyQuery(item, propertyName)+"$", projectData.PackageIndexSchemaValidationResult()[complianceLevel]) {
+			nonCompliantIDs = append(nonCompliantIDs, item.ID)
+			reportPackageIndexLocation(projectData, item.JSONPointer+"/"+propertyName)
+		}
+	}
+
+	return nonCompliantIDs
+}
+
+// packageIndexPropertyQuery returns the JSON schema property name query for the given property of the given package index data item.
+func packageIndexPropertyQuery(item projectdata.PackageIndexData, propertyName string) string {
+	return strings.TrimPrefix(item.JSONPointer, "/") + "/" + propertyName
+}
+
+// reportPackageIndexLocation records the location of a problem with the package index data at the given JSON pointer.
+func reportPackageIndexLocation(projectData *projectdata.Type, jsonPointer string) {
+	projectData.ReportLocation(rulelocation.Type{
+		Path: projectRelativePath(projectData, projectData.ProjectPath()),
+		Key:  jsonPointer,
+	})
+}
+
+// checksumRegexp matches the package index checksum format: the algorithm name followed by the hexadecimal digest.
+var checksumRegexp = regexp.MustCompile(`^(SHA-256:[0-9a-fA-F]{64}|SHA-1:[0-9a-fA-F]{40}|MD5:[0-9a-fA-F]{32})$`)
+
+// standardToolHosts are the hosts Boards Manager installs tools on, with the regular expressions Arduino CLI uses to select
+// the tool system for each.
+var standardToolHosts = []struct {
+	host       string
+	hostRegexp *regexp.Regexp
+}{
+	{"i686-linux-gnu", regexp.MustCompile("i[3456]86-.*linux-gnu")},
+	{"x86_64-linux-gnu", regexp.MustCompile("x86_64-.*linux-gnu")},
+	{"arm-linux-gnueabihf", regexp.MustCompile("arm.*-linux-gnueabihf")},
+	{"aarch64-linux-gnu", regexp.MustCompile("(aarch64|arm64)-linux-gnu")},
+	{"i686-mingw32", regexp.MustCompile("i[3456]86-.*(mingw32|cygwin)")},
+	{"x86_64-apple-darwin", regexp.MustCompile("(i[3456]86|x86_64)-apple-darwin.*")},
+}
+
+// packageIndexArchive is the package index data for a platform or tool release archive.
+type packageIndexArchive struct {
+	id              string // Human readable identifier for the release.
+	jsonPointer     string // JSON pointer to the release in the package index.
+	url             string
+	archiveFileName string
+	checksum        string
+	size            string
+}
+
+// packageIndexArchives returns the data for all the platform and tool release archives of the package index.
+func packageIndexArchives(projectData *projectdata.Type) []packageIndexArchive {
+	archives := []packageIndexArchive{}
+	for packageIndex, packageData := range projectData.PackageIndex().Packages {
+		for platformIndex, platformData := range packageData.Platforms {
+			archives = append(archives, packageIndexArchive{
+				id:              fmt.Sprintf("%s:%s@%s", packageData.Name, platformData.Architecture, platformData.Version),
+				jsonPointer:     fmt.Sprintf("/packages/%d/platforms/%d", packageIndex, platformIndex),
+				url:             platformData.URL,
+				archiveFileName: platformData.ArchiveFileName,
+				checksum:        platformData.Checksum,
+				size:            platformData.Size.String(),
+			})
+		}
+
+		for toolIndex, toolData := range packageData.Tools {
+			for systemIndex, systemData := range toolData.Systems {
+				archives = append(archives, packageIndexArchive{
+					id:              fmt.Sprintf("%s:%s@%s (%s)", packageData.Name, toolData.Name, toolData.Version, systemData.OS),
+					jsonPointer:     fmt.Sprintf("/packages/%d/tools/%d/systems/%d", packageIndex, toolIndex, systemIndex),
+					url:             systemData.URL,
+					archiveFileName: systemData.ArchiveFileName,
+					checksum:        systemData.Checksum,
+					size:            systemData.Size.String(),
+				})
+			}
+		}
+	}
+
+	return archives
+}
+
+// localPath returns the path of the release archive in the archives folder, or nil if it is not present.
+// The archive is looked up by the file name from its URL.
+func (archive packageIndexArchive) localPath() *paths.Path {
+	fileName := archive.archiveFileName
+	if archiveURL, err := url.Parse(archive.url); err == nil && path.Base(archiveURL.Path) != "." && path.Base(archiveURL.Path) != "/" {
+		fileName = path.Base(archiveURL.Path)
+	}
+	if fileName == "" {
+		return nil
+	}
+
+	archivePath := configuration.ArchivesPath().Join(fileName)
+	if !archivePath.Exist() || archivePath.IsDir() {
+		return nil
+	}
+
+	return archivePath
+}
+
+// fileDigest returns the hexadecimal digest of the file at the given path, using the given package index checksum algorithm.
+func fileDigest(filePath *paths.Path, algorithm string) (string, error) {
+	var hasher hash.Hash
+	switch algorithm {
+	case "SHA-256":
+		hasher = sha256.New()
+	case "SHA-1":
+		hasher = sha1.New()
+	case "MD5":
+		hasher = md5.New()
+	default:
+		return "", fmt.Errorf("Unsupported checksum algorithm %s", algorithm)
+	}
+
+	file, err := filePath.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
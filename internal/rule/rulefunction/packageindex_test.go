@@ -17,15 +17,19 @@ package rulefunction
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var packageIndexesTestDataPath *paths.Path
@@ -52,9 +56,9 @@ func checkPackageIndexRuleFunction(ruleFunction Type, testTables []packageIndexR
 			SuperprojectType: projecttype.PackageIndex,
 		}
 
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject)
 
-		result, output := ruleFunction()
+		result, output := ruleFunction(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 	}
@@ -88,3 +92,292 @@ func TestPackageIndexFormat(t *testing.T) {
 
 	checkPackageIndexRuleFunction(PackageIndexFormat, testTables, t)
 }
+
+func TestPackageIndexPackagesMaintainerMissing(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"Maintainer missing", "packages-maintainer-missing", ruleresult.Fail, "^myboard$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesMaintainerMissing, testTables, t)
+}
+
+func TestPackageIndexPackagesMaintainerLTMinLength(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"Maintainer missing", "packages-maintainer-missing", ruleresult.Pass, ""},
+		{"Maintainer less than minimum length", "packages-maintainer-lt-min-length", ruleresult.Fail, "^myboard$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesMaintainerLTMinLength, testTables, t)
+}
+
+func TestPackageIndexPackagesWebsiteURLMissing(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"websiteURL missing", "packages-websiteurl-missing", ruleresult.Fail, "^myboard$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesWebsiteURLMissing, testTables, t)
+}
+
+func TestPackageIndexPackagesHelpOnlineMissing(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"help missing", "packages-help-missing", ruleresult.Fail, "^myboard$"},
+		{"help.online missing", "packages-help-online-missing", ruleresult.Fail, "^myboard$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesHelpOnlineMissing, testTables, t)
+}
+
+func TestPackageIndexPackagesPlatformsArchitectureMissing(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No platforms", "no-platforms", ruleresult.Skip, ""},
+		{"Architecture missing", "platforms-architecture-missing", ruleresult.Fail, "^/packages/0/platforms/1$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesPlatformsArchitectureMissing, testTables, t)
+}
+
+func TestPackageIndexPackagesPlatformsArchitectureInvalid(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No platforms", "no-platforms", ruleresult.Skip, ""},
+		{"Architecture missing", "platforms-architecture-missing", ruleresult.Pass, ""},
+		{"Architecture invalid", "platforms-architecture-invalid", ruleresult.Fail, "^myboard:my/avr@1.0.1$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesPlatformsArchitectureInvalid, testTables, t)
+}
+
+func TestPackageIndexPackagesPlatformsCategoryMissing(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No platforms", "no-platforms", ruleresult.Skip, ""},
+		{"Category missing", "platforms-category-missing", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesPlatformsCategoryMissing, testTables, t)
+}
+
+func TestPackageIndexPackagesPlatformsCategoryInvalid(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No platforms", "no-platforms", ruleresult.Skip, ""},
+		{"Category missing", "platforms-category-missing", ruleresult.Pass, ""},
+		{"Category invalid", "platforms-category-invalid", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesPlatformsCategoryInvalid, testTables, t)
+}
+
+func TestPackageIndexPackagesPlatformsBoardsEmpty(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No platforms", "no-platforms", ruleresult.Skip, ""},
+		{"Boards missing", "platforms-boards-missing", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Boards empty", "platforms-boards-empty", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Valid", "valid-package-index", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesPlatformsBoardsEmpty, testTables, t)
+}
+
+// configureArchivesPath initializes the configuration with the given archives folder.
+func configureArchivesPath(archivesPath *paths.Path) {
+	flags := test.ConfigurationFlags()
+	flags.Set("library-index", testLibraryIndexPath.String())
+	flags.Set("archives-dir", archivesPath.String())
+	workingDirectory, _ := os.Getwd()
+	if err := configuration.Initialize(flags, []string{workingDirectory}); err != nil {
+		panic(err)
+	}
+}
+
+func TestPackageIndexChecksumInvalidFormat(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No archives", "no-packages", ruleresult.Skip, ""},
+		{"Invalid format", "archives-checksum-invalid-format", ruleresult.Fail, `^myboard:mytool@1.0.0 \(i686-mingw32\)$`},
+		{"Valid", "archives-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexChecksumInvalidFormat, testTables, t)
+}
+
+func TestPackageIndexChecksumMismatch(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Archives folder not specified", "archives-checksum-mismatch", ruleresult.Skip, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexChecksumMismatch, testTables, t)
+
+	configureArchivesPath(packageIndexesTestDataPath.Join("archives"))
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+
+	testTables = []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"Mismatch", "archives-checksum-mismatch", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Invalid format", "archives-checksum-invalid-format", ruleresult.Skip, "^Archives not found in the archives folder: myboard:avr@1.0.0$"},
+		{"Valid", "archives-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexChecksumMismatch, testTables, t)
+
+	archivesPath, err := paths.MkTempDir("", "arduino-lint-archives")
+	require.Nil(t, err)
+	defer archivesPath.RemoveAll()
+	configureArchivesPath(archivesPath)
+
+	testTables = []packageIndexRuleFunctionTestTable{
+		{"Archives not found", "archives-valid", ruleresult.Skip, `^Archives not found in the archives folder: myboard:avr@1.0.1, myboard:mytool@1.0.0 \(x86_64-linux-gnu\)$`},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexChecksumMismatch, testTables, t)
+}
+
+func TestPackageIndexSizeMismatch(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Archives folder not specified", "archives-size-mismatch", ruleresult.Skip, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexSizeMismatch, testTables, t)
+
+	configureArchivesPath(packageIndexesTestDataPath.Join("archives"))
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+
+	testTables = []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"Mismatch", "archives-size-mismatch", ruleresult.Fail, `^myboard:mytool@1.0.0 \(x86_64-linux-gnu\)$`},
+		{"Valid", "archives-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexSizeMismatch, testTables, t)
+
+	archivesPath, err := paths.MkTempDir("", "arduino-lint-archives")
+	require.Nil(t, err)
+	defer archivesPath.RemoveAll()
+	configureArchivesPath(archivesPath)
+
+	testTables = []packageIndexRuleFunctionTestTable{
+		{"Archives not found", "archives-valid", ruleresult.Skip, `^Archives not found in the archives folder: myboard:avr@1.0.1, myboard:mytool@1.0.0 \(x86_64-linux-gnu\)$`},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexSizeMismatch, testTables, t)
+}
+
+func TestPackageIndexToolsDependenciesUnresolved(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"Unresolved", "tools-dependencies-unresolved", ruleresult.Fail, "^myboard:avr@1.0.1 depends on myboard:mytool@1.0.1$"},
+		{"Dependencies on other package indexes", "valid-package-index", ruleresult.Pass, ""},
+		{"Valid", "tools-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexToolsDependenciesUnresolved, testTables, t)
+}
+
+func TestPackageIndexToolsSystemsMissing(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No tools", "valid-package-index", ruleresult.Skip, ""},
+		{"Systems missing", "tools-systems-missing", ruleresult.Fail, `^myboard:mytool@1.0.0 \(i686-linux-gnu, x86_64-apple-darwin\)$`},
+		{"Valid", "tools-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexToolsSystemsMissing, testTables, t)
+}
+
+func TestPackageIndexReleaseVersionDuplicate(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"Duplicate platform version", "releases-platform-version-duplicate", ruleresult.Fail, "^myboard:avr@1.0.0$"},
+		{"Duplicate tool version", "releases-tool-version-duplicate", ruleresult.Fail, "^myboard:mytool@1.0.0$"},
+		{"Valid", "tools-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexReleaseVersionDuplicate, testTables, t)
+}
+
+func TestPackageIndexReleaseVersionNonSemver(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No releases", "no-packages", ruleresult.Skip, ""},
+		{"Non-semver", "releases-version-non-semver", ruleresult.Fail, "^myboard:avr@1.1, myboard:myscripts@2.0$"},
+		{"Valid", "tools-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexReleaseVersionNonSemver, testTables, t)
+}
+
+func TestPackageIndexReleaseVersionOrder(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"Out of order", "releases-version-order", ruleresult.Fail, "^myboard:avr@1.0.2$"},
+		{"Newest first", "releases-version-order-newest-first", ruleresult.Pass, ""},
+		{"Newest first out of order", "releases-version-order-newest-first-invalid", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Duplicate version", "releases-platform-version-duplicate", ruleresult.Pass, ""},
+		{"Valid", "tools-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexReleaseVersionOrder, testTables, t)
+}
+
+func TestPackageIndexPlatformNameCategoryChanged(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No packages", "no-packages", ruleresult.Skip, ""},
+		{"Name changed", "releases-platform-name-changed", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Category changed", "releases-platform-category-changed", ruleresult.Fail, "^myboard:avr@1.0.1$"},
+		{"Valid", "tools-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPlatformNameCategoryChanged, testTables, t)
+}
+
+func TestPackageIndexArchiveFileNameCollision(t *testing.T) {
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Missing", "missing", ruleresult.NotRun, ""},
+		{"Invalid package index", "invalid-package-index", ruleresult.NotRun, ""},
+		{"No archives", "no-packages", ruleresult.Skip, ""},
+		{"Collision", "releases-archive-file-name-collision", ruleresult.Fail, `^myboard:avr@1.0.0 and myboard:avr@1.0.1 \(myboard-1.0.0.zip\)$`},
+		{"Valid", "tools-valid", ruleresult.Pass, ""},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexArchiveFileNameCollision, testTables, t)
+}
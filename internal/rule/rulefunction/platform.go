@@ -16,20 +16,23 @@
 package rulefunction
 
 import (
+	"regexp"
 	"strings"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
+	"github.com/arduino/go-properties-orderedmap"
 	"github.com/sirupsen/logrus"
 )
 
 // The rule functions for platforms.
 
 // BoardsTxtMissing checks whether the platform contains a boards.txt
-func BoardsTxtMissing() (result ruleresult.Type, output string) {
-	boardsTxtPath := projectdata.ProjectPath().Join("boards.txt")
+func BoardsTxtMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	boardsTxtPath := projectData.ProjectPath().Join("boards.txt")
 	exist, err := boardsTxtPath.ExistCheck()
 	if err != nil {
 		panic(err)
@@ -39,33 +42,36 @@ func BoardsTxtMissing() (result ruleresult.Type, output string) {
 		return ruleresult.Pass, ""
 	}
 
+	reportPathLocation(projectData, projectData.ProjectPath())
 	return ruleresult.Fail, boardsTxtPath.String()
 }
 
 // BoardsTxtFormat checks for invalid boards.txt format.
-func BoardsTxtFormat() (result ruleresult.Type, output string) {
-	if !projectdata.ProjectPath().Join("boards.txt").Exist() {
+func BoardsTxtFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.ProjectPath().Join("boards.txt").Exist() {
 		return ruleresult.NotRun, "boards.txt missing"
 	}
 
-	if projectdata.BoardsTxtLoadError() == nil {
+	if projectData.BoardsTxtLoadError() == nil {
 		return ruleresult.Pass, ""
 	}
 
-	return ruleresult.Fail, projectdata.BoardsTxtLoadError().Error()
+	reportPathLocation(projectData, projectData.ProjectPath().Join("boards.txt"))
+	return ruleresult.Fail, projectData.BoardsTxtLoadError().Error()
 }
 
 // BoardsTxtBoardIDNameMissing checks if any of the boards are missing name properties.
-func BoardsTxtBoardIDNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := iDMissingRequiredProperty(projectdata.BoardsTxtBoardIds(), "name", projectdata.BoardsTxtSchemaValidationResult()[compliancelevel.Specification])
+	nonCompliantBoardIDs := iDMissingRequiredProperty(projectData.BoardsTxtBoardIds(), "name", projectData.BoardsTxtSchemaValidationResult()[compliancelevel.Specification])
+	reportIDPropertyLocations(projectData, "boards.txt", nonCompliantBoardIDs, "name")
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -75,16 +81,16 @@ func BoardsTxtBoardIDNameMissing() (result ruleresult.Type, output string) {
 }
 
 // BoardsTxtBoardIDNameLTMinLength checks if any of the board names are less than the minimum length.
-func BoardsTxtBoardIDNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtBoardIds(), "name", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtBoardIds(), "name", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -94,16 +100,16 @@ func BoardsTxtBoardIDNameLTMinLength() (result ruleresult.Type, output string) {
 }
 
 // BoardsTxtBoardIDBuildBoardMissing checks if any of the boards are missing build.board properties.
-func BoardsTxtBoardIDBuildBoardMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDBuildBoardMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtBoardIds(), "build.board")
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtBoardIds(), "build.board")
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -113,16 +119,16 @@ func BoardsTxtBoardIDBuildBoardMissing() (result ruleresult.Type, output string)
 }
 
 // BoardsTxtBoardIDBuildBoardLTMinLength checks if any of the board build.board values are less than the minimum length.
-func BoardsTxtBoardIDBuildBoardLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDBuildBoardLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtBoardIds(), "build\\.board", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtBoardIds(), "build\\.board", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -132,16 +138,16 @@ func BoardsTxtBoardIDBuildBoardLTMinLength() (result ruleresult.Type, output str
 }
 
 // BoardsTxtBoardIDBuildCoreMissing checks if any of the boards are missing build.core properties.
-func BoardsTxtBoardIDBuildCoreMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDBuildCoreMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no visible boards"
 	}
 
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "build.core")
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "build.core")
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -151,16 +157,16 @@ func BoardsTxtBoardIDBuildCoreMissing() (result ruleresult.Type, output string)
 }
 
 // BoardsTxtBoardIDBuildCoreLTMinLength checks if any of the board build.core values are less than the minimum length.
-func BoardsTxtBoardIDBuildCoreLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDBuildCoreLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no visible boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtVisibleBoardIds(), "build\\.core", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtVisibleBoardIds(), "build\\.core", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -170,19 +176,20 @@ func BoardsTxtBoardIDBuildCoreLTMinLength() (result ruleresult.Type, output stri
 }
 
 // BoardsTxtUserExtraFlagsUsage checks if the user's compiler.x.extra_flags properties are used in boards.txt.
-func BoardsTxtUserExtraFlagsUsage() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtUserExtraFlagsUsage(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
 	nonCompliantBoardIDs := []string{}
-	for _, boardID := range projectdata.BoardsTxtBoardIds() {
-		if schema.ValidationErrorMatch("#/"+boardID, "/userExtraFlagsProperties/", "", "", projectdata.BoardsTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, boardID := range projectData.BoardsTxtBoardIds() {
+		if schema.ValidationErrorMatch("#/"+boardID, "/userExtraFlagsProperties/", "", "", projectData.BoardsTxtSchemaValidationResult()[compliancelevel.Strict]) {
 			nonCompliantBoardIDs = append(nonCompliantBoardIDs, boardID)
+			reportPropertyLocation(projectData, "boards.txt", boardID)
 		}
 	}
 
@@ -194,16 +201,16 @@ func BoardsTxtUserExtraFlagsUsage() (result ruleresult.Type, output string) {
 }
 
 // BoardsTxtBoardIDHideInvalid checks if any of the board hide values have invalid format
-func BoardsTxtBoardIDHideInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDHideInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "hide", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "hide", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -213,19 +220,20 @@ func BoardsTxtBoardIDHideInvalid() (result ruleresult.Type, output string) {
 }
 
 // BoardsTxtMenuMenuIDLTMinLength checks if any of the menu titles are less than the minimum length.
-func BoardsTxtMenuMenuIDLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtMenuMenuIDLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtMenuIds()) == 0 {
+	if len(projectData.BoardsTxtMenuIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no menus"
 	}
 
 	nonCompliantMenuIDs := []string{}
-	for _, menuID := range projectdata.BoardsTxtMenuIds() {
-		if schema.PropertyLessThanMinLength("menu/"+menuID, projectdata.BoardsTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, menuID := range projectData.BoardsTxtMenuIds() {
+		if schema.PropertyLessThanMinLength("menu/"+menuID, projectData.BoardsTxtSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantMenuIDs = append(nonCompliantMenuIDs, menuID)
+			reportPropertyLocation(projectData, "boards.txt", "menu."+menuID)
 		}
 	}
 
@@ -237,16 +245,16 @@ func BoardsTxtMenuMenuIDLTMinLength() (result ruleresult.Type, output string) {
 }
 
 // BoardsTxtBoardIDSerialDisableDTRInvalid checks if any of the board serial.disableDTR values are invalid.
-func BoardsTxtBoardIDSerialDisableDTRInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDSerialDisableDTRInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "serial\\.disableDTR", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "serial\\.disableDTR", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -256,16 +264,16 @@ func BoardsTxtBoardIDSerialDisableDTRInvalid() (result ruleresult.Type, output s
 }
 
 // BoardsTxtBoardIDSerialDisableRTSInvalid checks if any of the board serial.disableRTS values are invalid.
-func BoardsTxtBoardIDSerialDisableRTSInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDSerialDisableRTSInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "serial\\.disableRTS", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "serial\\.disableRTS", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -275,16 +283,16 @@ func BoardsTxtBoardIDSerialDisableRTSInvalid() (result ruleresult.Type, output s
 }
 
 // BoardsTxtBoardIDUploadToolMissing checks if any of the boards are missing upload.tool properties.
-func BoardsTxtBoardIDUploadToolMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadToolMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no visible boards"
 	}
 
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "upload.tool")
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "upload.tool")
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -294,16 +302,16 @@ func BoardsTxtBoardIDUploadToolMissing() (result ruleresult.Type, output string)
 }
 
 // BoardsTxtBoardIDUploadToolLTMinLength checks if any of the board upload.tool values are less than the minimum length.
-func BoardsTxtBoardIDUploadToolLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadToolLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtBoardIds(), "upload\\.tool", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtBoardIds(), "upload\\.tool", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -313,16 +321,16 @@ func BoardsTxtBoardIDUploadToolLTMinLength() (result ruleresult.Type, output str
 }
 
 // BoardsTxtBoardIDUploadMaximumSizeMissing checks if any of the boards are missing upload.maximum_size properties.
-func BoardsTxtBoardIDUploadMaximumSizeMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadMaximumSizeMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no visible boards"
 	}
 
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "upload.maximum_size")
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "upload.maximum_size")
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -332,16 +340,16 @@ func BoardsTxtBoardIDUploadMaximumSizeMissing() (result ruleresult.Type, output
 }
 
 // BoardsTxtBoardIDUploadMaximumSizeInvalid checks if any of the board upload.maximum_size values have an invalid format.
-func BoardsTxtBoardIDUploadMaximumSizeInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadMaximumSizeInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "upload\\.maximum_size", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload\\.maximum_size", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -351,16 +359,16 @@ func BoardsTxtBoardIDUploadMaximumSizeInvalid() (result ruleresult.Type, output
 }
 
 // BoardsTxtBoardIDUploadMaximumDataSizeMissing checks if any of the boards are missing upload.maximum_data_size properties.
-func BoardsTxtBoardIDUploadMaximumDataSizeMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadMaximumDataSizeMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no visible boards"
 	}
 
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "upload.maximum_data_size")
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "upload.maximum_data_size")
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -370,16 +378,16 @@ func BoardsTxtBoardIDUploadMaximumDataSizeMissing() (result ruleresult.Type, out
 }
 
 // BoardsTxtBoardIDUploadMaximumDataSizeInvalid checks if any of the board upload.maximum_data_size values have an invalid format.
-func BoardsTxtBoardIDUploadMaximumDataSizeInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadMaximumDataSizeInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "upload\\.maximum_data_size", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload\\.maximum_data_size", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -389,16 +397,16 @@ func BoardsTxtBoardIDUploadMaximumDataSizeInvalid() (result ruleresult.Type, out
 }
 
 // BoardsTxtBoardIDUploadUse1200bpsTouchInvalid checks if any of the board upload.use_1200bps_touch values are invalid.
-func BoardsTxtBoardIDUploadUse1200bpsTouchInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadUse1200bpsTouchInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "upload\\.use_1200bps_touch", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload\\.use_1200bps_touch", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -408,16 +416,16 @@ func BoardsTxtBoardIDUploadUse1200bpsTouchInvalid() (result ruleresult.Type, out
 }
 
 // BoardsTxtBoardIDUploadWaitForUploadPortInvalid checks if any of the board upload.wait_for_upload_port values are invalid.
-func BoardsTxtBoardIDUploadWaitForUploadPortInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDUploadWaitForUploadPortInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "upload\\.wait_for_upload_port", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload\\.wait_for_upload_port", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -427,16 +435,16 @@ func BoardsTxtBoardIDUploadWaitForUploadPortInvalid() (result ruleresult.Type, o
 }
 
 // BoardsTxtBoardIDVidNInvalid checks if any of the board vid.n values have an invalid format.
-func BoardsTxtBoardIDVidNInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDVidNInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "vid\\.[0-9]+", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "vid\\.[0-9]+", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -446,16 +454,16 @@ func BoardsTxtBoardIDVidNInvalid() (result ruleresult.Type, output string) {
 }
 
 // BoardsTxtBoardIDPidNInvalid checks if any of the board pid.n values have an invalid format.
-func BoardsTxtBoardIDPidNInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
+func BoardsTxtBoardIDPidNInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
 		return ruleresult.Skip, "boards.txt has no boards"
 	}
 
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "pid\\.[0-9]+", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "pid\\.[0-9]+", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
@@ -465,33 +473,34 @@ func BoardsTxtBoardIDPidNInvalid() (result ruleresult.Type, output string) {
 }
 
 // ProgrammersTxtFormat checks for invalid programmers.txt format.
-func ProgrammersTxtFormat() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
+func ProgrammersTxtFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.ProgrammersTxtExists() {
 		return ruleresult.Skip, "Platform has no programmers.txt"
 	}
 
-	if projectdata.ProgrammersTxtLoadError() == nil {
+	if projectData.ProgrammersTxtLoadError() == nil {
 		return ruleresult.Pass, ""
 	}
 
-	return ruleresult.Fail, projectdata.ProgrammersTxtLoadError().Error()
+	reportPathLocation(projectData, projectData.ProjectPath().Join("programmers.txt"))
+	return ruleresult.Fail, projectData.ProgrammersTxtLoadError().Error()
 }
 
 // ProgrammersTxtProgrammerIDNameMissing checks if any of the programmers are missing name properties.
-func ProgrammersTxtProgrammerIDNameMissing() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
+func ProgrammersTxtProgrammerIDNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.ProgrammersTxtExists() {
 		return ruleresult.Skip, "Platform has no programmers.txt"
 	}
 
-	if projectdata.ProgrammersTxtLoadError() != nil {
+	if projectData.ProgrammersTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load programmers.txt"
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
 		return ruleresult.Skip, "programmers.txt has no programmers"
 	}
 
-	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty("name", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty(projectData, "name", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
@@ -501,20 +510,20 @@ func ProgrammersTxtProgrammerIDNameMissing() (result ruleresult.Type, output str
 }
 
 // ProgrammersTxtProgrammerIDNameLTMinLength checks if any of the programmer names are less than the minimum length.
-func ProgrammersTxtProgrammerIDNameLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
+func ProgrammersTxtProgrammerIDNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.ProgrammersTxtExists() {
 		return ruleresult.Skip, "Platform has no programmers.txt"
 	}
 
-	if projectdata.ProgrammersTxtLoadError() != nil {
+	if projectData.ProgrammersTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load programmers.txt"
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
 		return ruleresult.Skip, "programmers.txt has no programmers"
 	}
 
-	nonCompliantProgrammerIDs := programmerIDValueLTMinLength("name", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDValueLTMinLength(projectData, "name", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
@@ -524,20 +533,20 @@ func ProgrammersTxtProgrammerIDNameLTMinLength() (result ruleresult.Type, output
 }
 
 // ProgrammersTxtProgrammerIDProgramToolMissing checks if any of the programmers are missing program.tool properties.
-func ProgrammersTxtProgrammerIDProgramToolMissing() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
+func ProgrammersTxtProgrammerIDProgramToolMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.ProgrammersTxtExists() {
 		return ruleresult.Skip, "Platform has no programmers.txt"
 	}
 
-	if projectdata.ProgrammersTxtLoadError() != nil {
+	if projectData.ProgrammersTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load programmers.txt"
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
 		return ruleresult.Skip, "programmers.txt has no programmers"
 	}
 
-	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty("program\\.tool", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty(projectData, "program\\.tool", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
@@ -547,20 +556,20 @@ func ProgrammersTxtProgrammerIDProgramToolMissing() (result ruleresult.Type, out
 }
 
 // ProgrammersTxtProgrammerIDProgramToolLTMinLength checks if any of the programmer program.tool properties are less than the minimum length.
-func ProgrammersTxtProgrammerIDProgramToolLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
+func ProgrammersTxtProgrammerIDProgramToolLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.ProgrammersTxtExists() {
 		return ruleresult.Skip, "Platform has no programmers.txt"
 	}
 
-	if projectdata.ProgrammersTxtLoadError() != nil {
+	if projectData.ProgrammersTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load programmers.txt"
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
 		return ruleresult.Skip, "programmers.txt has no programmers"
 	}
 
-	nonCompliantProgrammerIDs := programmerIDValueLTMinLength("program\\.tool", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDValueLTMinLength(projectData, "program\\.tool", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
@@ -570,29 +579,31 @@ func ProgrammersTxtProgrammerIDProgramToolLTMinLength() (result ruleresult.Type,
 }
 
 // PlatformTxtFormat checks for invalid platform.txt format.
-func PlatformTxtFormat() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() == nil {
+	if projectData.PlatformTxtLoadError() == nil {
 		return ruleresult.Pass, ""
 	}
 
-	return ruleresult.Fail, projectdata.PlatformTxtLoadError().Error()
+	reportPathLocation(projectData, projectData.ProjectPath().Join("platform.txt"))
+	return ruleresult.Fail, projectData.PlatformTxtLoadError().Error()
 }
 
 // PlatformTxtNameMissing checks for missing name property in platform.txt.
-func PlatformTxtNameMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if schema.RequiredPropertyMissing("name", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("name", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "name")
 		return ruleresult.Fail, ""
 	}
 
@@ -600,20 +611,21 @@ func PlatformTxtNameMissing() (result ruleresult.Type, output string) {
 }
 
 // PlatformTxtNameLTMinLength checks if the platform.txt name property value is less than the minimum length.
-func PlatformTxtNameLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("name") {
+	if !projectData.PlatformTxt().ContainsKey("name") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("name", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("name", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "name")
 		return ruleresult.Fail, ""
 	}
 
@@ -621,16 +633,17 @@ func PlatformTxtNameLTMinLength() (result ruleresult.Type, output string) {
 }
 
 // PlatformTxtVersionMissing checks for missing version property in platform.txt.
-func PlatformTxtVersionMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtVersionMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if schema.RequiredPropertyMissing("version", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("version", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "version")
 		return ruleresult.Fail, ""
 	}
 
@@ -638,21 +651,22 @@ func PlatformTxtVersionMissing() (result ruleresult.Type, output string) {
 }
 
 // PlatformTxtVersionNonRelaxedSemver checks whether the platform.txt version property is "relaxed semver" compliant.
-func PlatformTxtVersionNonRelaxedSemver() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtVersionNonRelaxedSemver(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	version, ok := projectdata.PlatformTxt().GetOk("version")
+	version, ok := projectData.PlatformTxt().GetOk("version")
 	if !ok {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyPatternMismatch("version", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "version")
 		return ruleresult.Fail, version
 	}
 
@@ -660,21 +674,22 @@ func PlatformTxtVersionNonRelaxedSemver() (result ruleresult.Type, output string
 }
 
 // PlatformTxtVersionNonSemver checks whether the platform.txt version property is semver compliant.
-func PlatformTxtVersionNonSemver() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtVersionNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	version, ok := projectdata.PlatformTxt().GetOk("version")
+	version, ok := projectData.PlatformTxt().GetOk("version")
 	if !ok {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("version", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "version")
 		return ruleresult.Fail, version
 	}
 
@@ -682,24 +697,25 @@ func PlatformTxtVersionNonSemver() (result ruleresult.Type, output string) {
 }
 
 // PlatformTxtCompilerWarningFlagsNoneMissing checks for missing compiler.warning_flags.none property in platform.txt.
-func PlatformTxtCompilerWarningFlagsNoneMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerWarningFlagsNoneMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.none", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.none", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.warning_flags.none")
 		return ruleresult.Fail, ""
 	}
 
@@ -707,24 +723,25 @@ func PlatformTxtCompilerWarningFlagsNoneMissing() (result ruleresult.Type, outpu
 }
 
 // PlatformTxtCompilerWarningFlagsDefaultMissing checks for missing compiler.warning_flags.default property in platform.txt.
-func PlatformTxtCompilerWarningFlagsDefaultMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerWarningFlagsDefaultMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.default", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.default", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.warning_flags.default")
 		return ruleresult.Fail, ""
 	}
 
@@ -732,24 +749,25 @@ func PlatformTxtCompilerWarningFlagsDefaultMissing() (result ruleresult.Type, ou
 }
 
 // PlatformTxtCompilerWarningFlagsMoreMissing checks for missing compiler.warning_flags.more property in platform.txt.
-func PlatformTxtCompilerWarningFlagsMoreMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerWarningFlagsMoreMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.more", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.more", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.warning_flags.more")
 		return ruleresult.Fail, ""
 	}
 
@@ -757,24 +775,25 @@ func PlatformTxtCompilerWarningFlagsMoreMissing() (result ruleresult.Type, outpu
 }
 
 // PlatformTxtCompilerWarningFlagsAllMissing checks for missing compiler.warning_flags.all property in platform.txt.
-func PlatformTxtCompilerWarningFlagsAllMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerWarningFlagsAllMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.all", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.all", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.warning_flags.all")
 		return ruleresult.Fail, ""
 	}
 
@@ -782,28 +801,29 @@ func PlatformTxtCompilerWarningFlagsAllMissing() (result ruleresult.Type, output
 }
 
 // PlatformTxtCompilerOptimizationFlagsDebugMissing checks for missing compiler.optimization_flags.debug property in platform.txt.
-func PlatformTxtCompilerOptimizationFlagsDebugMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerOptimizationFlagsDebugMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.optimization_flags.release") {
+	if !projectData.PlatformTxt().ContainsKey("compiler.optimization_flags.release") {
 		return ruleresult.Skip, "Dependent property not present"
 	}
 
-	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.release", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.release", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.optimization_flags.release")
 		return ruleresult.Fail, ""
 	}
 
@@ -811,28 +831,29 @@ func PlatformTxtCompilerOptimizationFlagsDebugMissing() (result ruleresult.Type,
 }
 
 // PlatformTxtCompilerOptimizationFlagsReleaseMissing checks for missing compiler.optimization_flags.release property in platform.txt.
-func PlatformTxtCompilerOptimizationFlagsReleaseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerOptimizationFlagsReleaseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.optimization_flags.debug") {
+	if !projectData.PlatformTxt().ContainsKey("compiler.optimization_flags.debug") {
 		return ruleresult.Skip, "Dependent property not present"
 	}
 
-	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.debug", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.debug", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.optimization_flags.debug")
 		return ruleresult.Fail, ""
 	}
 
@@ -840,24 +861,25 @@ func PlatformTxtCompilerOptimizationFlagsReleaseMissing() (result ruleresult.Typ
 }
 
 // PlatformTxtCompilerCExtraFlagsMissing checks for missing compiler.c.extra_flags property in platform.txt.
-func PlatformTxtCompilerCExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerCExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.c\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.c\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.c.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -865,20 +887,21 @@ func PlatformTxtCompilerCExtraFlagsMissing() (result ruleresult.Type, output str
 }
 
 // PlatformTxtCompilerCExtraFlagsNotEmpty checks for non-empty compiler.c.extra_flags property in platform.txt.
-func PlatformTxtCompilerCExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerCExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.c.extra_flags") {
+	if !projectData.PlatformTxt().ContainsKey("compiler.c.extra_flags") {
 		return ruleresult.Skip, "Property not present"
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.c\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyEnumMismatch("compiler\\.c\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.c.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -886,24 +909,25 @@ func PlatformTxtCompilerCExtraFlagsNotEmpty() (result ruleresult.Type, output st
 }
 
 // PlatformTxtCompilerCppExtraFlagsMissing checks for missing compiler.cpp.extra_flags property in platform.txt.
-func PlatformTxtCompilerCppExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerCppExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.cpp\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.cpp\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.cpp.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -911,20 +935,21 @@ func PlatformTxtCompilerCppExtraFlagsMissing() (result ruleresult.Type, output s
 }
 
 // PlatformTxtCompilerCppExtraFlagsNotEmpty checks for non-empty compiler.cpp.extra_flags property in platform.txt.
-func PlatformTxtCompilerCppExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerCppExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.cpp.extra_flags") {
+	if !projectData.PlatformTxt().ContainsKey("compiler.cpp.extra_flags") {
 		return ruleresult.Skip, "Property not present"
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.cpp\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyEnumMismatch("compiler\\.cpp\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.cpp.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -932,24 +957,25 @@ func PlatformTxtCompilerCppExtraFlagsNotEmpty() (result ruleresult.Type, output
 }
 
 // PlatformTxtCompilerSExtraFlagsMissing checks for missing compiler.S.extra_flags property in platform.txt.
-func PlatformTxtCompilerSExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerSExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.S\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.S\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.S.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -957,20 +983,21 @@ func PlatformTxtCompilerSExtraFlagsMissing() (result ruleresult.Type, output str
 }
 
 // PlatformTxtCompilerSExtraFlagsNotEmpty checks for non-empty compiler.S.extra_flags property in platform.txt.
-func PlatformTxtCompilerSExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerSExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.S.extra_flags") {
+	if !projectData.PlatformTxt().ContainsKey("compiler.S.extra_flags") {
 		return ruleresult.Skip, "Property not present"
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.S\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyEnumMismatch("compiler\\.S\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.S.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -978,24 +1005,25 @@ func PlatformTxtCompilerSExtraFlagsNotEmpty() (result ruleresult.Type, output st
 }
 
 // PlatformTxtCompilerArExtraFlagsMissing checks for missing compiler.ar.extra_flags property in platform.txt.
-func PlatformTxtCompilerArExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerArExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.ar\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.ar\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.ar.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -1003,20 +1031,21 @@ func PlatformTxtCompilerArExtraFlagsMissing() (result ruleresult.Type, output st
 }
 
 // PlatformTxtCompilerArExtraFlagsNotEmpty checks for non-empty compiler.ar.extra_flags property in platform.txt.
-func PlatformTxtCompilerArExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerArExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.ar.extra_flags") {
+	if !projectData.PlatformTxt().ContainsKey("compiler.ar.extra_flags") {
 		return ruleresult.Skip, "Property not present"
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.ar\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyEnumMismatch("compiler\\.ar\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.ar.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -1024,24 +1053,25 @@ func PlatformTxtCompilerArExtraFlagsNotEmpty() (result ruleresult.Type, output s
 }
 
 // PlatformTxtCompilerCElfExtraFlagsMissing checks for missing compiler.c.elf.extra_flags property in platform.txt.
-func PlatformTxtCompilerCElfExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerCElfExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.c\\.elf\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("compiler\\.c\\.elf\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.c.elf.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -1049,20 +1079,21 @@ func PlatformTxtCompilerCElfExtraFlagsMissing() (result ruleresult.Type, output
 }
 
 // PlatformTxtCompilerCExtraFlagsNotEmpty checks for non-empty compiler.c.extra_flags property in platform.txt.
-func PlatformTxtCompilerCElfExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtCompilerCElfExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.c.elf.extra_flags") {
+	if !projectData.PlatformTxt().ContainsKey("compiler.c.elf.extra_flags") {
 		return ruleresult.Skip, "Property not present"
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.c\\.elf\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyEnumMismatch("compiler\\.c\\.elf\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "compiler.c.elf.extra_flags")
 		return ruleresult.Fail, ""
 	}
 
@@ -1070,20 +1101,21 @@ func PlatformTxtCompilerCElfExtraFlagsNotEmpty() (result ruleresult.Type, output
 }
 
 // PlatformTxtRecipePreprocMacrosLTMinLength checks if the platform.txt recipe.preproc.macros property value is less than the minimum length.
-func PlatformTxtRecipePreprocMacrosLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipePreprocMacrosLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.preproc.macros") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.preproc.macros") {
 		return ruleresult.Skip, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.preproc\\.macros", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.preproc\\.macros", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.preproc.macros")
 		return ruleresult.Fail, ""
 	}
 
@@ -1091,20 +1123,21 @@ func PlatformTxtRecipePreprocMacrosLTMinLength() (result ruleresult.Type, output
 }
 
 // PlatformTxtRecipePreprocMacrosExtraFlagsSupport checks if platform.txt recipe.preproc.macros provides support for user extra flags.
-func PlatformTxtRecipePreprocMacrosExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipePreprocMacrosExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.preproc.macros") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.preproc.macros") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.preproc\\.macros", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("recipe\\.preproc\\.macros", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.preproc.macros")
 		return ruleresult.Fail, ""
 	}
 
@@ -1112,24 +1145,25 @@ func PlatformTxtRecipePreprocMacrosExtraFlagsSupport() (result ruleresult.Type,
 }
 
 // PlatformTxtRecipeCOPatternMissing checks for missing recipe.c.o.pattern property in platform.txt.
-func PlatformTxtRecipeCOPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCOPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.c\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("recipe\\.c\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.c.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1137,20 +1171,21 @@ func PlatformTxtRecipeCOPatternMissing() (result ruleresult.Type, output string)
 }
 
 // PlatformTxtRecipeCOPatternLTMinLength checks if the platform.txt recipe.c.o.pattern property value is less than the minimum length.
-func PlatformTxtRecipeCOPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCOPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.c\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.c\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.c.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1158,20 +1193,21 @@ func PlatformTxtRecipeCOPatternLTMinLength() (result ruleresult.Type, output str
 }
 
 // PlatformTxtRecipeCOPatternExtraFlagsSupport checks if platform.txt recipe.c.o.pattern provides support for user extra flags.
-func PlatformTxtRecipeCOPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCOPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.c\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("recipe\\.c\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.c.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1179,24 +1215,25 @@ func PlatformTxtRecipeCOPatternExtraFlagsSupport() (result ruleresult.Type, outp
 }
 
 // PlatformTxtRecipeCppOPatternMissing checks for missing recipe.cpp.o.pattern property in platform.txt.
-func PlatformTxtRecipeCppOPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCppOPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.cpp\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("recipe\\.cpp\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.cpp.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1204,20 +1241,21 @@ func PlatformTxtRecipeCppOPatternMissing() (result ruleresult.Type, output strin
 }
 
 // PlatformTxtRecipeCppOPatternLTMinLength checks if the platform.txt recipe.cpp.o.pattern property value is less than the minimum length.
-func PlatformTxtRecipeCppOPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCppOPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.cpp\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.cpp\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.cpp.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1225,20 +1263,21 @@ func PlatformTxtRecipeCppOPatternLTMinLength() (result ruleresult.Type, output s
 }
 
 // PlatformTxtRecipeCppOPatternExtraFlagsSupport checks if platform.txt recipe.cpp.o.pattern provides support for user extra flags.
-func PlatformTxtRecipeCppOPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCppOPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.cpp\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("recipe\\.cpp\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.cpp.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1246,24 +1285,25 @@ func PlatformTxtRecipeCppOPatternExtraFlagsSupport() (result ruleresult.Type, ou
 }
 
 // PlatformTxtRecipeSOPatternMissing checks for missing recipe.S.o.pattern property in platform.txt.
-func PlatformTxtRecipeSOPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeSOPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.S\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("recipe\\.S\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.S.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1271,20 +1311,21 @@ func PlatformTxtRecipeSOPatternMissing() (result ruleresult.Type, output string)
 }
 
 // PlatformTxtRecipeSOPatternLTMinLength checks if the platform.txt recipe.S.o.pattern property value is less than the minimum length.
-func PlatformTxtRecipeSOPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeSOPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.S\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.S\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.S.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1292,20 +1333,21 @@ func PlatformTxtRecipeSOPatternLTMinLength() (result ruleresult.Type, output str
 }
 
 // PlatformTxtRecipeSOPatternExtraFlagsSupport checks if platform.txt recipe.S.o.pattern provides support for user extra flags.
-func PlatformTxtRecipeSOPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeSOPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.S\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("recipe\\.S\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.S.o.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1313,24 +1355,25 @@ func PlatformTxtRecipeSOPatternExtraFlagsSupport() (result ruleresult.Type, outp
 }
 
 // PlatformTxtRecipeArPatternMissing checks for missing recipe.ar.pattern property in platform.txt.
-func PlatformTxtRecipeArPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeArPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.ar\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("recipe\\.ar\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.ar.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1338,20 +1381,21 @@ func PlatformTxtRecipeArPatternMissing() (result ruleresult.Type, output string)
 }
 
 // PlatformTxtRecipeArPatternLTMinLength checks if the platform.txt recipe.ar.pattern property value is less than the minimum length.
-func PlatformTxtRecipeArPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeArPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.ar.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.ar.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.ar\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.ar\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.ar.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1359,20 +1403,21 @@ func PlatformTxtRecipeArPatternLTMinLength() (result ruleresult.Type, output str
 }
 
 // PlatformTxtRecipeArPatternExtraFlagsSupport checks if platform.txt recipe.ar.pattern provides support for user extra flags.
-func PlatformTxtRecipeArPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeArPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.ar.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.ar.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.ar\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("recipe\\.ar\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.ar.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1380,24 +1425,25 @@ func PlatformTxtRecipeArPatternExtraFlagsSupport() (result ruleresult.Type, outp
 }
 
 // PlatformTxtRecipeCCombinePatternMissing checks for missing recipe.c.combine.pattern property in platform.txt.
-func PlatformTxtRecipeCCombinePatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCCombinePatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.c\\.combine\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("recipe\\.c\\.combine\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.c.combine.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1405,20 +1451,21 @@ func PlatformTxtRecipeCCombinePatternMissing() (result ruleresult.Type, output s
 }
 
 // PlatformTxtRecipeCCombinePatternLTMinLength checks if the platform.txt recipe.c.combine.pattern property value is less than the minimum length.
-func PlatformTxtRecipeCCombinePatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCCombinePatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.c\\.combine\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.c\\.combine\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.c.combine.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1426,20 +1473,21 @@ func PlatformTxtRecipeCCombinePatternLTMinLength() (result ruleresult.Type, outp
 }
 
 // PlatformTxtRecipeCCombinePatternExtraFlagsSupport checks if platform.txt recipe.c.combine.pattern provides support for user extra flags.
-func PlatformTxtRecipeCCombinePatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeCCombinePatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.c\\.combine\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyPatternMismatch("recipe\\.c\\.combine\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.c.combine.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1447,24 +1495,25 @@ func PlatformTxtRecipeCCombinePatternExtraFlagsSupport() (result ruleresult.Type
 }
 
 // PlatformTxtRecipeOutputTmpFileMissing checks for missing recipe.output.tmp_file property in platform.txt.
-func PlatformTxtRecipeOutputTmpFileMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeOutputTmpFileMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.output\\.tmp_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("recipe\\.output\\.tmp_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.output.tmp_file")
 		return ruleresult.Fail, ""
 	}
 
@@ -1472,20 +1521,21 @@ func PlatformTxtRecipeOutputTmpFileMissing() (result ruleresult.Type, output str
 }
 
 // PlatformTxtRecipeOutputTmpFileLTMinLength checks if the platform.txt recipe.output.tmp_file property value is less than the minimum length.
-func PlatformTxtRecipeOutputTmpFileLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeOutputTmpFileLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.output.tmp_file") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.output.tmp_file") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.output\\.tmp_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.output\\.tmp_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.output.tmp_file")
 		return ruleresult.Fail, ""
 	}
 
@@ -1493,24 +1543,25 @@ func PlatformTxtRecipeOutputTmpFileLTMinLength() (result ruleresult.Type, output
 }
 
 // PlatformTxtRecipeOutputSaveFileMissing checks for missing recipe.output.save_file property in platform.txt.
-func PlatformTxtRecipeOutputSaveFileMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeOutputSaveFileMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.output\\.save_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.RequiredPropertyMissing("recipe\\.output\\.save_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.output.save_file")
 		return ruleresult.Fail, ""
 	}
 
@@ -1518,20 +1569,21 @@ func PlatformTxtRecipeOutputSaveFileMissing() (result ruleresult.Type, output st
 }
 
 // PlatformTxtRecipeOutputSaveFileLTMinLength checks if the platform.txt recipe.output.save_file property value is less than the minimum length.
-func PlatformTxtRecipeOutputSaveFileLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeOutputSaveFileLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.output.save_file") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.output.save_file") {
 		return ruleresult.NotRun, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.output\\.save_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	if schema.PropertyLessThanMinLength("recipe\\.output\\.save_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.output.save_file")
 		return ruleresult.Fail, ""
 	}
 
@@ -1539,24 +1591,25 @@ func PlatformTxtRecipeOutputSaveFileLTMinLength() (result ruleresult.Type, outpu
 }
 
 // PlatformTxtRecipeSizePatternMissing checks for missing recipe.size.pattern property in platform.txt.
-func PlatformTxtRecipeSizePatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeSizePatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.size\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("recipe\\.size\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.size.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1564,20 +1617,21 @@ func PlatformTxtRecipeSizePatternMissing() (result ruleresult.Type, output strin
 }
 
 // PlatformTxtRecipeSizePatternLTMinLength checks if the platform.txt recipe.size.pattern property value is less than the minimum length.
-func PlatformTxtRecipeSizePatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeSizePatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.size.pattern") {
+	if !projectData.PlatformTxt().ContainsKey("recipe.size.pattern") {
 		return ruleresult.Skip, "Property not present"
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.size\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.PropertyLessThanMinLength("recipe\\.size\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.size.pattern")
 		return ruleresult.Fail, ""
 	}
 
@@ -1585,24 +1639,25 @@ func PlatformTxtRecipeSizePatternLTMinLength() (result ruleresult.Type, output s
 }
 
 // PlatformTxtRecipeSizeRegexMissing checks for missing recipe.size.regex property in platform.txt.
-func PlatformTxtRecipeSizeRegexMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeSizeRegexMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.size\\.regex", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("recipe\\.size\\.regex", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.size.regex")
 		return ruleresult.Fail, ""
 	}
 
@@ -1610,24 +1665,25 @@ func PlatformTxtRecipeSizeRegexMissing() (result ruleresult.Type, output string)
 }
 
 // PlatformTxtRecipeSizeRegexDataMissing checks for missing recipe.size.regex.data property in platform.txt.
-func PlatformTxtRecipeSizeRegexDataMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtRecipeSizeRegexDataMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
+	if projectData.BoardsTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load boards.txt"
 	}
 
-	if platformReferencesCore() {
+	if platformReferencesCore(projectData) {
 		return ruleresult.Skip, "Core reference used"
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.size\\.regex\\.data", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	if schema.RequiredPropertyMissing("recipe\\.size\\.regex\\.data", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		reportPropertyLocation(projectData, "platform.txt", "recipe.size.regex.data")
 		return ruleresult.Fail, ""
 	}
 
@@ -1635,20 +1691,20 @@ func PlatformTxtRecipeSizeRegexDataMissing() (result ruleresult.Type, output str
 }
 
 // PlatformTxtUploadParamsVerboseMissing checks if any of the tools are missing upload.params.verbose properties.
-func PlatformTxtUploadParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtUploadParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("upload/params\\.verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "upload/params\\.verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1658,20 +1714,20 @@ func PlatformTxtUploadParamsVerboseMissing() (result ruleresult.Type, output str
 }
 
 // PlatformTxtUploadParamsQuietMissing checks if any of the programmers are missing upload.params.quiet properties.
-func PlatformTxtUploadParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtUploadParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("upload/params\\.quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "upload/params\\.quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1681,20 +1737,20 @@ func PlatformTxtUploadParamsQuietMissing() (result ruleresult.Type, output strin
 }
 
 // PlatformTxtUploadPatternMissing checks if any of the programmers are missing upload.pattern properties.
-func PlatformTxtUploadPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtUploadPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("upload/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "upload/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1704,20 +1760,20 @@ func PlatformTxtUploadPatternMissing() (result ruleresult.Type, output string) {
 }
 
 // PlatformTxtProgramParamsVerboseMissing checks if any of the tools are missing program.params.verbose properties.
-func PlatformTxtProgramParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtProgramParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("program/params\\.verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "program/params\\.verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1727,20 +1783,20 @@ func PlatformTxtProgramParamsVerboseMissing() (result ruleresult.Type, output st
 }
 
 // PlatformTxtProgramParamsQuietMissing checks if any of the programmers are missing program.params.quiet properties.
-func PlatformTxtProgramParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtProgramParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("program/params\\.quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "program/params\\.quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1750,20 +1806,20 @@ func PlatformTxtProgramParamsQuietMissing() (result ruleresult.Type, output stri
 }
 
 // PlatformTxtProgramPatternMissing checks if any of the programmers are missing program.pattern properties.
-func PlatformTxtProgramPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtProgramPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("program/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "program/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1773,20 +1829,20 @@ func PlatformTxtProgramPatternMissing() (result ruleresult.Type, output string)
 }
 
 // PlatformTxtEraseParamsVerboseMissing checks if any of the tools are missing erase.params.verbose properties.
-func PlatformTxtEraseParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtEraseParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("erase/params\\.verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "erase/params\\.verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1796,20 +1852,20 @@ func PlatformTxtEraseParamsVerboseMissing() (result ruleresult.Type, output stri
 }
 
 // PlatformTxtEraseParamsQuietMissing checks if any of the programmers are missing erase.params.quiet properties.
-func PlatformTxtEraseParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtEraseParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("erase/params\\.quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "erase/params\\.quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1819,20 +1875,20 @@ func PlatformTxtEraseParamsQuietMissing() (result ruleresult.Type, output string
 }
 
 // PlatformTxtErasePatternMissing checks if any of the programmers are missing erase.pattern properties.
-func PlatformTxtErasePatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtErasePatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("erase/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "erase/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1842,20 +1898,20 @@ func PlatformTxtErasePatternMissing() (result ruleresult.Type, output string) {
 }
 
 // PlatformTxtBootloaderParamsVerboseMissing checks if any of the tools are missing bootloader.params.verbose properties.
-func PlatformTxtBootloaderParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtBootloaderParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("bootloader/params\\.verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "bootloader/params\\.verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1865,20 +1921,20 @@ func PlatformTxtBootloaderParamsVerboseMissing() (result ruleresult.Type, output
 }
 
 // PlatformTxtBootloaderParamsQuietMissing checks if any of the programmers are missing bootloader.params.quiet properties.
-func PlatformTxtBootloaderParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtBootloaderParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("bootloader/params\\.quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "bootloader/params\\.quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1888,20 +1944,20 @@ func PlatformTxtBootloaderParamsQuietMissing() (result ruleresult.Type, output s
 }
 
 // PlatformTxtBootloaderPatternMissing checks if any of the programmers are missing bootloader.pattern properties.
-func PlatformTxtBootloaderPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
+func PlatformTxtBootloaderPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if !projectData.PlatformTxtExists() {
 		return ruleresult.Skip, "Platform has no platform.txt"
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
+	if projectData.PlatformTxtLoadError() != nil {
 		return ruleresult.NotRun, "Couldn't load platform.txt"
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
+	if len(projectData.PlatformTxtToolNames()) == 0 {
 		return ruleresult.Skip, "platform.txt has no tools"
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("bootloader/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "bootloader/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
 		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
@@ -1910,18 +1966,200 @@ func PlatformTxtBootloaderPatternMissing() (result ruleresult.Type, output strin
 	return ruleresult.Pass, ""
 }
 
+// PlatformPackageIndexReleaseMissing checks whether the package index specified by the --package-index flag has a release of the platform.
+func PlatformPackageIndexReleaseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if configuration.PackageIndexPath() == nil {
+		return ruleresult.Skip, "Package index not specified"
+	}
+
+	if projectData.PlatformPackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load package index: " + projectData.PlatformPackageIndexLoadError().Error()
+	}
+
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt"
+	}
+
+	if projectData.PlatformTxtLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load platform.txt"
+	}
+
+	name, nameOK := projectData.PlatformTxt().GetOk("name")
+	version, versionOK := projectData.PlatformTxt().GetOk("version")
+	if !nameOK || !versionOK {
+		return ruleresult.NotRun, "platform.txt name or version not defined"
+	}
+
+	if platformPackageIndexRelease(projectData) == nil {
+		reportPropertyLocation(projectData, "platform.txt", "version")
+		return ruleresult.Fail, name + "@" + version
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PlatformPackageIndexBoardsMissing checks whether any of the visible boards of the platform are missing from the boards list of its package index release.
+func PlatformPackageIndexBoardsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if configuration.PackageIndexPath() == nil {
+		return ruleresult.Skip, "Package index not specified"
+	}
+
+	if projectData.PlatformPackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load package index: " + projectData.PlatformPackageIndexLoadError().Error()
+	}
+
+	if projectData.BoardsTxtLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load boards.txt"
+	}
+
+	release := platformPackageIndexRelease(projectData)
+	if release == nil {
+		return ruleresult.NotRun, "No release of the platform in the package index"
+	}
+
+	indexBoardNames := map[string]bool{}
+	for _, boardName := range release.boardNames {
+		indexBoardNames[boardName] = true
+	}
+
+	nonCompliantBoardIDs := []string{}
+	for _, boardID := range projectData.BoardsTxtVisibleBoardIds() {
+		if !indexBoardNames[projectData.BoardsTxt().Get(boardID+".name")] {
+			nonCompliantBoardIDs = append(nonCompliantBoardIDs, boardID)
+		}
+	}
+
+	if len(nonCompliantBoardIDs) > 0 {
+		reportIDPropertyLocations(projectData, "boards.txt", nonCompliantBoardIDs, "name")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PlatformPackageIndexBoardsUnknown checks whether the boards list of the platform's package index release has any boards not visible in boards.txt.
+func PlatformPackageIndexBoardsUnknown(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if configuration.PackageIndexPath() == nil {
+		return ruleresult.Skip, "Package index not specified"
+	}
+
+	if projectData.PlatformPackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load package index: " + projectData.PlatformPackageIndexLoadError().Error()
+	}
+
+	if projectData.BoardsTxtLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load boards.txt"
+	}
+
+	release := platformPackageIndexRelease(projectData)
+	if release == nil {
+		return ruleresult.NotRun, "No release of the platform in the package index"
+	}
+
+	boardNames := map[string]bool{}
+	for _, boardID := range projectData.BoardsTxtVisibleBoardIds() {
+		boardNames[projectData.BoardsTxt().Get(boardID+".name")] = true
+	}
+
+	nonCompliantBoardNames := []string{}
+	for _, boardName := range release.boardNames {
+		if !boardNames[boardName] {
+			nonCompliantBoardNames = append(nonCompliantBoardNames, boardName)
+		}
+	}
+
+	if len(nonCompliantBoardNames) > 0 {
+		// The package index may be outside the project, so the problem is located at the platform.txt property which identifies the release.
+		reportPropertyLocation(projectData, "platform.txt", "version")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardNames, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
+// PlatformPackageIndexToolsDependenciesMissing checks whether any of the tools used by the boards or programmers of the platform are missing from the tool dependencies of its package index release.
+func PlatformPackageIndexToolsDependenciesMissing(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	if configuration.PackageIndexPath() == nil {
+		return ruleresult.Skip, "Package index not specified"
+	}
+
+	if projectData.PlatformPackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load package index: " + projectData.PlatformPackageIndexLoadError().Error()
+	}
+
+	if projectData.BoardsTxtLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load boards.txt"
+	}
+
+	release := platformPackageIndexRelease(projectData)
+	if release == nil {
+		return ruleresult.NotRun, "No release of the platform in the package index"
+	}
+
+	dependencyReferences := map[string]bool{}
+	dependencyNames := map[string]bool{}
+	for _, dependency := range release.toolDependencies {
+		dependencyReferences[dependency.packager+":"+dependency.name] = true
+		dependencyNames[dependency.name] = true
+	}
+
+	nonCompliantToolReferences := []string{}
+	reportedToolReferences := map[string]bool{}
+	checkToolReferences := func(fileName string, propertiesMap *properties.Map, iDs []string, keyRegexp *regexp.Regexp) {
+		for _, iD := range iDs {
+			iDProperties := propertiesMap.SubTree(iD)
+			for _, key := range iDProperties.Keys() {
+				if !keyRegexp.MatchString(key) {
+					continue
+				}
+
+				toolReference := iDProperties.Get(key)
+				if toolReference == "" {
+					continue
+				}
+				if strings.Contains(toolReference, ":") {
+					if dependencyReferences[toolReference] {
+						continue
+					}
+				} else if dependencyNames[toolReference] {
+					// A tool referenced without a packager (e.g., avrdude rather than arduino:avrdude) uses the tools.<name>
+					// recipe of the platform, and its binary may be provided by a tool dependency from any packager.
+					continue
+				}
+
+				reportPropertyLocation(projectData, fileName, iD+"."+key)
+				if !reportedToolReferences[toolReference] {
+					reportedToolReferences[toolReference] = true
+					nonCompliantToolReferences = append(nonCompliantToolReferences, toolReference)
+				}
+			}
+		}
+	}
+
+	checkToolReferences("boards.txt", projectData.BoardsTxt(), projectData.BoardsTxtBoardIds(), uploadToolKeyRegexp)
+	if projectData.ProgrammersTxtLoadError() == nil {
+		checkToolReferences("programmers.txt", projectData.ProgrammersTxt(), projectData.ProgrammersTxtProgrammerIds(), programToolKeyRegexp)
+	}
+
+	if len(nonCompliantToolReferences) > 0 {
+		return ruleresult.Fail, strings.Join(nonCompliantToolReferences, ", ")
+	}
+
+	return ruleresult.Pass, ""
+}
+
 /*
 boardIDMissingRequiredProperty returns the list of board IDs missing the given property.
 Unlike iDMissingRequiredProperty(), this function does a direct check on the properties, rather than using the JSON schema validation.
 This is necessary because JSON schema does not have the capability to account for the custom board options system.
 This function should not be used in cases where the JSON schema does cover a required property.
 */
-func boardIDMissingRequiredProperty(boardIDs []string, propertyName string) []string {
+func boardIDMissingRequiredProperty(projectData *projectdata.Type, boardIDs []string, propertyName string) []string {
 	nonCompliantBoardIDs := []string{}
 	for _, boardID := range boardIDs {
 		logrus.Tracef("Board ID: %s", boardID)
 		boardIDHasProperty := func(boardID string, propertyName string) bool {
-			if projectdata.BoardsTxt().ContainsKey(boardID + "." + propertyName) {
+			if projectData.BoardsTxt().ContainsKey(boardID + "." + propertyName) {
 				logrus.Trace("Property defined at top level\n")
 				return true // The board has a first level definition of the property. No need to check custom board options.
 
@@ -1929,7 +2167,7 @@ func boardIDMissingRequiredProperty(boardIDs []string, propertyName string) []st
 
 			// There is no first level definition of the property, so check if it is provided by the custom board options.
 			menuProvidesProperty := false
-			boardMenuProperties := projectdata.BoardsTxt().SubTree(boardID + ".menu")
+			boardMenuProperties := projectData.BoardsTxt().SubTree(boardID + ".menu")
 			boardMenuIDs := boardMenuProperties.FirstLevelKeys()
 			for _, boardMenuID := range boardMenuIDs {
 				logrus.Tracef("Menu ID: %s\n", boardMenuID)
@@ -1960,59 +2198,94 @@ func boardIDMissingRequiredProperty(boardIDs []string, propertyName string) []st
 		}
 	}
 
+	reportIDPropertyLocations(projectData, "boards.txt", nonCompliantBoardIDs, propertyName)
 	return nonCompliantBoardIDs
 }
 
 // boardIDValueLTMinLength returns the list of board IDs with value of the given property less than the minimum length.
-func boardIDValueLTMinLength(boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	nonCompliantBoardIDs := iDValueLTMinLength(boardIDs, propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])
-	return append(nonCompliantBoardIDs, iDValueLTMinLength(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+func boardIDValueLTMinLength(projectData *projectdata.Type, boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantBoardIDs := iDValueLTMinLength(boardIDs, propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])
+	nonCompliantBoardIDs = append(nonCompliantBoardIDs, iDValueLTMinLength(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+	reportIDPropertyLocations(projectData, "boards.txt", nonCompliantBoardIDs, propertyNameQuery)
+	return nonCompliantBoardIDs
 }
 
 // boardIDValueEnumMismatch returns the list of board IDs with value of the given property less than the minimum length.
-func boardIDValueEnumMismatch(boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	nonCompliantBoardIDs := iDValueEnumMismatch(boardIDs, propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])
-	return append(nonCompliantBoardIDs, iDValueEnumMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+func boardIDValueEnumMismatch(projectData *projectdata.Type, boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantBoardIDs := iDValueEnumMismatch(boardIDs, propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])
+	nonCompliantBoardIDs = append(nonCompliantBoardIDs, iDValueEnumMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+	reportIDPropertyLocations(projectData, "boards.txt", nonCompliantBoardIDs, propertyNameQuery)
+	return nonCompliantBoardIDs
 }
 
 // boardIDValuePatternMismatch returns the list of board IDs with value of the given property less than the minimum length.
-func boardIDValuePatternMismatch(boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	nonCompliantBoardIDs := iDValuePatternMismatch(boardIDs, propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])
-	return append(nonCompliantBoardIDs, iDValuePatternMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+func boardIDValuePatternMismatch(projectData *projectdata.Type, boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantBoardIDs := iDValuePatternMismatch(boardIDs, propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])
+	nonCompliantBoardIDs = append(nonCompliantBoardIDs, iDValuePatternMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+	reportIDPropertyLocations(projectData, "boards.txt", nonCompliantBoardIDs, propertyNameQuery)
+	return nonCompliantBoardIDs
 }
 
 // programmerIDMissingRequiredProperty returns the list of programmer IDs missing the given required property.
-func programmerIDMissingRequiredProperty(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDMissingRequiredProperty(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDMissingRequiredProperty(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantProgrammerIDs := iDMissingRequiredProperty(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+	reportIDPropertyLocations(projectData, "programmers.txt", nonCompliantProgrammerIDs, propertyNameQuery)
+	return nonCompliantProgrammerIDs
 }
 
 // programmerIDValueLTMinLength returns the list of programmer IDs with value of the given property less than the minimum length.
-func programmerIDValueLTMinLength(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDValueLTMinLength(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDValueLTMinLength(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantProgrammerIDs := iDValueLTMinLength(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+	reportIDPropertyLocations(projectData, "programmers.txt", nonCompliantProgrammerIDs, propertyNameQuery)
+	return nonCompliantProgrammerIDs
 }
 
 // programmerIDValueEnumMismatch returns the list of programmer IDs with value of the given property not matching the JSON schema enum.
-func programmerIDValueEnumMismatch(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDValueEnumMismatch(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDValueEnumMismatch(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantProgrammerIDs := iDValueEnumMismatch(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+	reportIDPropertyLocations(projectData, "programmers.txt", nonCompliantProgrammerIDs, propertyNameQuery)
+	return nonCompliantProgrammerIDs
 }
 
 // programmerIDValueEnumMismatch returns the list of programmer IDs with value of the given property not matching the JSON schema pattern.
-func programmerIDValuePatternMismatch(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDValuePatternMismatch(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDValuePatternMismatch(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantProgrammerIDs := iDValuePatternMismatch(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+	reportIDPropertyLocations(projectData, "programmers.txt", nonCompliantProgrammerIDs, propertyNameQuery)
+	return nonCompliantProgrammerIDs
 }
 
 // toolNameMissingRequiredProperty returns the list of tool names missing the given required property.
-func toolNameMissingRequiredProperty(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+func toolNameMissingRequiredProperty(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
 	nonCompliantTools := []string{}
-	for _, tool := range projectdata.PlatformTxtToolNames() {
-		if schema.RequiredPropertyMissing("tools/"+tool+"/"+propertyNameQuery, projectdata.PlatformTxtSchemaValidationResult()[complianceLevel]) {
+	for _, tool := range projectData.PlatformTxtToolNames() {
+		if schema.RequiredPropertyMissing("tools/"+tool+"/"+propertyNameQuery, projectData.PlatformTxtSchemaValidationResult()[complianceLevel]) {
 			nonCompliantTools = append(nonCompliantTools, tool)
+			reportPropertyLocation(projectData, "platform.txt", "tools."+tool+"."+propertyKey(propertyNameQuery))
 		}
 	}
 
 	return nonCompliantTools
 }
 
+// reportIDPropertyLocations records the locations of the given property of each of the given IDs in the given properties file.
+func reportIDPropertyLocations(projectData *projectdata.Type, fileName string, iDs []string, propertyNameQuery string) {
+	for _, iD := range iDs {
+		reportPropertyLocation(projectData, fileName, iD+"."+propertyKey(propertyNameQuery))
+	}
+}
+
+// propertyKey returns the properties file key corresponding to the given JSON schema property name query.
+// Any part of the query after a regular expression construct is discarded.
+func propertyKey(propertyNameQuery string) string {
+	key := strings.ReplaceAll(propertyNameQuery, `\.`, ".")
+	key = strings.ReplaceAll(key, "/", ".")
+	if metacharacterIndex := strings.IndexAny(key, `[]()*+?^$|\`); metacharacterIndex >= 0 {
+		key = key[:metacharacterIndex]
+	}
+
+	return strings.TrimSuffix(key, ".")
+}
+
 // iDMissingRequiredProperty returns the list of first level keys missing the given required property.
 func iDMissingRequiredProperty(iDs []string, propertyNameQuery string, validationResult schema.ValidationResult) []string {
 	nonCompliantIDs := []string{}
@@ -2063,12 +2336,12 @@ func iDValuePatternMismatch(iDs []string, propertyNameQuery string, validationRe
 
 // platformReferencesCore checks whether all boards of the platform use core references.
 // See: https://arduino.github.io/arduino-cli/dev/platform-specification/#core-reference
-func platformReferencesCore() bool {
+func platformReferencesCore(projectData *projectdata.Type) bool {
 	referencesCore := false // Default return value.
-	for _, boardID := range projectdata.BoardsTxt().FirstLevelKeys() {
+	for _, boardID := range projectData.BoardsTxt().FirstLevelKeys() {
 		if boardID != "menu" {
 			// It is a board ID.
-			boardProperties := projectdata.BoardsTxt().SubTree(boardID)
+			boardProperties := projectData.BoardsTxt().SubTree(boardID)
 			for _, key := range boardProperties.Keys() {
 				if key == "build.core" || strings.HasSuffix(key, ".build.core") {
 					if !strings.Contains(boardProperties.ExpandPropsInString(boardProperties.Get(key)), ":") {
@@ -2083,3 +2356,67 @@ func platformReferencesCore() bool {
 
 	return referencesCore
 }
+
+// uploadToolKeyRegexp matches the boards.txt keys, relative to the board ID, of the upload tool properties, including those of
+// the custom board options and of the upload protocols.
+var uploadToolKeyRegexp = regexp.MustCompile(`^(menu\.[^.]+\.[^.]+\.)?upload\.tool(\.[^.]+)?$`)
+
+// programToolKeyRegexp matches the programmers.txt keys, relative to the programmer ID, of the program tool properties.
+var programToolKeyRegexp = regexp.MustCompile(`^program\.tool(\.[^.]+)?$`)
+
+// platformPackageIndexReleaseType is the package index data for a platform release.
+type platformPackageIndexReleaseType struct {
+	architecture     string
+	boardNames       []string
+	toolDependencies []platformPackageIndexToolDependencyType
+}
+
+// platformPackageIndexToolDependencyType is the package index data for a tool dependency of a platform release.
+type platformPackageIndexToolDependencyType struct {
+	packager string
+	name     string
+}
+
+// platformPackageIndexRelease returns the release of the platform in the package index specified by the --package-index
+// flag, or nil if there is none. The release is identified by the name and version properties of platform.txt, and by
+// the architecture, which is the name of the platform folder. Platforms in folders not named for their architecture are
+// only identified if there is a single release with that name and version.
+func platformPackageIndexRelease(projectData *projectdata.Type) *platformPackageIndexReleaseType {
+	if projectData.PlatformPackageIndex() == nil || projectData.PlatformTxt() == nil {
+		return nil
+	}
+
+	name := projectData.PlatformTxt().Get("name")
+	version := projectData.PlatformTxt().Get("version")
+	architecture := projectData.ProjectPath().Base()
+	candidates := []*platformPackageIndexReleaseType{}
+	for _, packageData := range projectData.PlatformPackageIndex().Packages {
+		for _, platformData := range packageData.Platforms {
+			if platformData.Name != name || platformData.Version == nil || platformData.Version.String() != version {
+				continue
+			}
+
+			release := platformPackageIndexReleaseType{architecture: platformData.Architecture}
+			for _, board := range platformData.Boards {
+				release.boardNames = append(release.boardNames, board.Name)
+			}
+			for _, dependency := range platformData.ToolDependencies {
+				release.toolDependencies = append(release.toolDependencies, platformPackageIndexToolDependencyType{
+					packager: dependency.Packager,
+					name:     dependency.Name,
+				})
+			}
+
+			if release.architecture == architecture {
+				return &release
+			}
+			candidates = append(candidates, &release)
+		}
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	return nil
+}
@@ -17,13 +17,17 @@ package rulefunction
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -57,9 +61,9 @@ func checkPlatformRuleFunction(ruleFunction Type, testTables []platformRuleFunct
 			SuperprojectType: projecttype.Platform,
 		}
 
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject)
 
-		result, output := ruleFunction()
+		result, output := ruleFunction(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 	}
@@ -1086,3 +1090,156 @@ func TestPlatformTxtBootloaderPatternMissing(t *testing.T) {
 
 	checkPlatformRuleFunction(PlatformTxtBootloaderPatternMissing, testTables, t)
 }
+
+// configurePackageIndexPath initializes the configuration with the given package index file.
+func configurePackageIndexPath(packageIndexPath *paths.Path) {
+	flags := test.ConfigurationFlags()
+	flags.Set("library-index", testLibraryIndexPath.String())
+	flags.Set("package-index", packageIndexPath.String())
+	workingDirectory, _ := os.Getwd()
+	if err := configuration.Initialize(flags, []string{workingDirectory}); err != nil {
+		panic(err)
+	}
+}
+
+func TestPlatformPackageIndexReleaseMissing(t *testing.T) {
+	testTables := []platformRuleFunctionTestTable{
+		{"Package index not specified", "package-index-release-missing", ruleresult.Skip, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexReleaseMissing, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "invalid-package-index.json"))
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Invalid package index", "package-index-valid", ruleresult.NotRun, "^Couldn't load package index: .+"},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexReleaseMissing, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "package_myboard_index.json"))
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
+		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
+		{"Version missing", "version-missing-platform.txt", ruleresult.NotRun, ""},
+		{"Release missing", "package-index-release-missing", ruleresult.Fail, "^My Board@1.0.1$"},
+		{"Valid", "package-index-valid", ruleresult.Pass, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexReleaseMissing, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "package_ambiguous_index.json"))
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Ambiguous release", "package-index-valid", ruleresult.Fail, "^My Board@1.0.0$"},
+		{"Release identified by architecture", "package-index-architecture/avr", ruleresult.Pass, ""},
+		{"Release identified by architecture", "package-index-architecture/samd", ruleresult.Pass, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexReleaseMissing, testTables, t)
+}
+
+func TestPlatformPackageIndexBoardsMissing(t *testing.T) {
+	testTables := []platformRuleFunctionTestTable{
+		{"Package index not specified", "package-index-boards-mismatch", ruleresult.Skip, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexBoardsMissing, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "invalid-package-index.json"))
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Invalid package index", "package-index-valid", ruleresult.NotRun, "^Couldn't load package index: .+"},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexBoardsMissing, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "package_myboard_index.json"))
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Invalid boards.txt", "invalid-boards.txt", ruleresult.NotRun, ""},
+		{"Release missing", "package-index-release-missing", ruleresult.NotRun, ""},
+		{"Boards missing", "package-index-boards-mismatch", ruleresult.Fail, "^myboardmini$"},
+		{"Valid", "package-index-valid", ruleresult.Pass, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexBoardsMissing, testTables, t)
+}
+
+func TestPlatformPackageIndexBoardsUnknown(t *testing.T) {
+	testTables := []platformRuleFunctionTestTable{
+		{"Package index not specified", "package-index-boards-mismatch", ruleresult.Skip, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexBoardsUnknown, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "invalid-package-index.json"))
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Invalid package index", "package-index-valid", ruleresult.NotRun, "^Couldn't load package index: .+"},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexBoardsUnknown, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "package_myboard_index.json"))
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Invalid boards.txt", "invalid-boards.txt", ruleresult.NotRun, ""},
+		{"Release missing", "package-index-release-missing", ruleresult.NotRun, ""},
+		{"Unknown boards", "package-index-boards-mismatch", ruleresult.Fail, "^My Board Pro$"},
+		{"Valid", "package-index-valid", ruleresult.Pass, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexBoardsUnknown, testTables, t)
+
+	projectData := projectdata.Initialize(project.Type{
+		Path:             platformTestDataPath.Join("package-index-boards-mismatch"),
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.Platform,
+	})
+	result, _ := PlatformPackageIndexBoardsUnknown(projectData)
+	assert.Equal(t, ruleresult.Fail, result)
+	assert.Equal(t, []rulelocation.Type{{Path: "platform.txt", Line: 2, Column: 1, Key: "version"}}, projectData.Locations())
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "package_ambiguous_index.json"))
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Release of other architecture not used", "package-index-architecture/samd", ruleresult.Pass, ""},
+		{"Release of other architecture not used", "package-index-architecture/avr", ruleresult.Pass, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexBoardsUnknown, testTables, t)
+}
+
+func TestPlatformPackageIndexToolsDependenciesMissing(t *testing.T) {
+	testTables := []platformRuleFunctionTestTable{
+		{"Package index not specified", "package-index-tools-dependencies-missing", ruleresult.Skip, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexToolsDependenciesMissing, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "invalid-package-index.json"))
+	defer configureLibraryIndex(testLibraryIndexPath.String(), false)
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Invalid package index", "package-index-valid", ruleresult.NotRun, "^Couldn't load package index: .+"},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexToolsDependenciesMissing, testTables, t)
+
+	configurePackageIndexPath(platformTestDataPath.Join("package-index", "package_myboard_index.json"))
+
+	testTables = []platformRuleFunctionTestTable{
+		{"Invalid boards.txt", "invalid-boards.txt", ruleresult.NotRun, ""},
+		{"Release missing", "package-index-release-missing", ruleresult.NotRun, ""},
+		{"Dependencies missing", "package-index-tools-dependencies-missing", ruleresult.Fail, "^bossac, arduino_ota, vendor:mytool, arduino:openocd$"},
+		{"Dependency from other packager", "package-index-tools-dependencies-other-packager", ruleresult.Pass, ""},
+		{"Valid", "package-index-valid", ruleresult.Pass, ""},
+	}
+
+	checkPlatformRuleFunction(PlatformPackageIndexToolsDependenciesMissing, testTables, t)
+}
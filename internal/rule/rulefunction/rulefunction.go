@@ -18,37 +18,100 @@ package rulefunction
 
 import (
 	"encoding/json"
-	"fmt"
+	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/sketch"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/go-paths-helper"
 )
 
 // Type is the function signature for the rule functions.
 // The `output` result is the contextual information that will be inserted into the rule's message template.
-type Type func() (result ruleresult.Type, output string)
+// In addition, rule functions may report the locations of the problems they find via the report*Location functions.
+type Type func(projectData *projectdata.Type) (result ruleresult.Type, output string)
+
+// reportPathLocation records the location of a problem with the given file or folder.
+func reportPathLocation(projectData *projectdata.Type, path *paths.Path) {
+	projectData.ReportLocation(rulelocation.Type{Path: projectRelativePath(projectData, path)})
+}
+
+// reportLineLocation records the location of a problem at the given line and column of the given file.
+func reportLineLocation(projectData *projectdata.Type, path *paths.Path, line int, column int) {
+	projectData.ReportLocation(rulelocation.Type{Path: projectRelativePath(projectData, path), Line: line, Column: column})
+}
+
+// reportPropertyLocation records the location of a problem with the given property of the given properties file in the project root.
+// If the property is not present in the file, the line of the first property under the closest parent key is used.
+// Problems with missing properties which have no parent key in the file are reported without a line.
+func reportPropertyLocation(projectData *projectdata.Type, fileName string, key string) {
+	filePath := projectData.ProjectPath().Join(fileName)
+	location := rulelocation.Type{
+		Path: projectRelativePath(projectData, filePath),
+		Key:  key,
+	}
+
+	lines, err := filePath.ReadFileAsLines()
+	if err == nil {
+		for query := key; query != "" && location.Line == 0; {
+			// Match the property itself or any property under it.
+			keyRegexp := regexp.MustCompile(`^\s*` + regexp.QuoteMeta(query) + `(\s*=|\.)`)
+			for lineIndex, line := range lines {
+				if keyRegexp.MatchString(line) {
+					location.Line = lineIndex + 1
+					location.Column = strings.Index(line, query) + 1
+					break
+				}
+			}
+
+			if lastSeparatorIndex := strings.LastIndex(query, "."); lastSeparatorIndex >= 0 {
+				query = query[:lastSeparatorIndex]
+			} else {
+				query = ""
+			}
+		}
+	}
+
+	projectData.ReportLocation(location)
+}
+
+// projectRelativePath returns the slash-separated path of the given path, relative to the project.
+func projectRelativePath(projectData *projectdata.Type, path *paths.Path) string {
+	basePath := projectData.ProjectPath()
+	if basePath.IsNotDir() {
+		// Package index projects are files.
+		basePath = basePath.Parent()
+	}
+
+	relativePath, err := path.RelFrom(basePath)
+	if err != nil {
+		return filepath.ToSlash(path.String())
+	}
+
+	return filepath.ToSlash(relativePath.String())
+}
 
 // MissingReadme checks if the project has a readme that will be recognized by GitHub.
-func MissingReadme() (result ruleresult.Type, output string) {
+func MissingReadme(projectData *projectdata.Type) (result ruleresult.Type, output string) {
 	// https://github.com/github/markup/blob/master/README.md
 	readmeRegexp := regexp.MustCompile(`(?i)^readme\.(markdown)|(mdown)|(mkdn)|(md)|(textile)|(rdoc)|(org)|(creole)|(mediawiki)|(wiki)|(rst)|(asciidoc)|(adoc)|(asc)|(pod)|(txt)$`)
 
 	// https://docs.github.com/en/free-pro-team@latest/github/creating-cloning-and-archiving-repositories/about-readmes#about-readmes
-	if pathContainsRegexpMatch(projectdata.ProjectPath(), readmeRegexp) ||
-		(projectdata.ProjectPath().Join("docs").Exist() && pathContainsRegexpMatch(projectdata.ProjectPath().Join("docs"), readmeRegexp)) ||
-		(projectdata.ProjectPath().Join(".github").Exist() && pathContainsRegexpMatch(projectdata.ProjectPath().Join(".github"), readmeRegexp)) {
+	if pathContainsRegexpMatch(projectData.ProjectPath(), readmeRegexp) ||
+		(projectData.ProjectPath().Join("docs").Exist() && pathContainsRegexpMatch(projectData.ProjectPath().Join("docs"), readmeRegexp)) ||
+		(projectData.ProjectPath().Join(".github").Exist() && pathContainsRegexpMatch(projectData.ProjectPath().Join(".github"), readmeRegexp)) {
 		return ruleresult.Pass, ""
 	}
 
+	reportPathLocation(projectData, projectData.ProjectPath())
 	return ruleresult.Fail, ""
 }
 
 // MissingLicenseFile checks if the project has a license file that will be recognized by GitHub.
-func MissingLicenseFile() (result ruleresult.Type, output string) {
+func MissingLicenseFile(projectData *projectdata.Type) (result ruleresult.Type, output string) {
 	// https://docs.github.com/en/free-pro-team@latest/github/creating-cloning-and-archiving-repositories/licensing-a-repository#detecting-a-license
 	// https://github.com/licensee/licensee/blob/master/docs/what-we-look-at.md#detecting-the-license-file
 	// Should be `(?i)^(((un)?licen[sc]e)|(copy(ing|right))|(ofl)|(patents))(\.(?!spdx|header|gemspec).+)?$` but regexp package doesn't support negative lookahead, so only using "preferred extensions".
@@ -56,18 +119,19 @@ func MissingLicenseFile() (result ruleresult.Type, output string) {
 	licenseRegexp := regexp.MustCompile(`(?i)^(((un)?licen[sc]e)|(copy(ing|right))|(ofl)|(patents))(\.((md)|(markdown)|(txt)|(html)))?$`)
 
 	// License file must be in root of repo
-	if pathContainsRegexpMatch(projectdata.ProjectPath(), licenseRegexp) {
+	if pathContainsRegexpMatch(projectData.ProjectPath(), licenseRegexp) {
 		return ruleresult.Pass, ""
 	}
 
+	reportPathLocation(projectData, projectData.ProjectPath())
 	return ruleresult.Fail, ""
 }
 
 // IncorrectArduinoDotHFileNameCase checks for incorrect file name case of Arduino.h in #include directives.
-func IncorrectArduinoDotHFileNameCase() (result ruleresult.Type, output string) {
+func IncorrectArduinoDotHFileNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string) {
 	incorrectCaseRegexp := regexp.MustCompile(`^\s*#\s*include\s*["<](a((?i)rduino)|(ARDUINO))\.[hH][">]`)
 
-	directoryListing, err := projectdata.ProjectPath().ReadDirRecursive()
+	directoryListing, err := projectData.ProjectPath().ReadDirRecursive()
 	if err != nil {
 		panic(err)
 	}
@@ -85,7 +149,8 @@ func IncorrectArduinoDotHFileNameCase() (result ruleresult.Type, output string)
 
 		for lineNumber, line := range lines {
 			if incorrectCaseRegexp.MatchString(line) {
-				return ruleresult.Fail, fmt.Sprintf("%s:%v: %s", file, lineNumber+1, line)
+				reportLineLocation(projectData, file, lineNumber+1, strings.Index(line, "#")+1)
+				return ruleresult.Fail, strings.TrimSpace(line)
 			}
 		}
 	}
@@ -21,10 +21,13 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/rulelocation"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,6 +37,24 @@ var testDataPath *paths.Path
 func init() {
 	workingDirectory, _ := os.Getwd()
 	testDataPath = paths.New(workingDirectory, "testdata", "general")
+
+	testLibraryIndexPath = paths.New(workingDirectory, "testdata", "library_index.json")
+
+	// Use a local Library Manager index so the tests don't depend on the contents of the real index.
+	configureLibraryIndex(testLibraryIndexPath.String(), false)
+}
+
+var testLibraryIndexPath *paths.Path
+
+// configureLibraryIndex initializes the configuration with the given Library Manager index and offline mode settings.
+func configureLibraryIndex(libraryIndex string, offline bool) {
+	flags := test.ConfigurationFlags()
+	flags.Set("library-index", libraryIndex)
+	flags.Set("offline", fmt.Sprint(offline))
+	workingDirectory, _ := os.Getwd()
+	if err := configuration.Initialize(flags, []string{workingDirectory}); err != nil {
+		panic(err)
+	}
 }
 
 type ruleFunctionTestTable struct {
@@ -53,9 +74,9 @@ func checkRuleFunction(ruleFunction Type, testTables []ruleFunctionTestTable, t
 			SuperprojectType: projecttype.Library,
 		}
 
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject)
 
-		result, output := ruleFunction()
+		result, output := ruleFunction(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 	}
@@ -82,10 +103,76 @@ func TestMissingLicenseFile(t *testing.T) {
 
 func TestIncorrectArduinoDotHFileNameCase(t *testing.T) {
 	testTables := []ruleFunctionTestTable{
-		{"Incorrect, angle brackets", "arduino.h-angle", ruleresult.Fail, ""},
-		{"Incorrect, quotes", "arduino.h-quote", ruleresult.Fail, ""},
+		{"Incorrect, angle brackets", "arduino.h-angle", ruleresult.Fail, "^#include <arduino.h>$"},
+		{"Incorrect, quotes", "arduino.h-quote", ruleresult.Fail, `^#include "arduino.h"$`},
 		{"Correct case", "Arduino.h", ruleresult.Pass, ""},
 	}
 
 	checkRuleFunction(IncorrectArduinoDotHFileNameCase, testTables, t)
 }
+
+func TestLocations(t *testing.T) {
+	testProject := project.Type{
+		Path:             platformTestDataPath.Join("boardID-upload-tool-LT-boards.txt"),
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.Platform,
+	}
+	projectData := projectdata.Initialize(testProject)
+
+	result, _ := BoardsTxtBoardIDUploadToolLTMinLength(projectData)
+	assert.Equal(t, ruleresult.Fail, result)
+	assert.Equal(
+		t,
+		[]rulelocation.Type{
+			{Path: "boards.txt", Line: 5, Column: 1, Key: "buno.upload.tool"},
+			{Path: "boards.txt", Line: 21, Column: 1, Key: "funo.upload.tool"},
+			{Path: "boards.txt", Line: 29, Column: 1, Key: "zuno.upload.tool"},
+		},
+		projectData.Locations(),
+	)
+	assert.Empty(t, projectData.Locations(), "Locations should be cleared after retrieval")
+
+	testProject.Path = platformTestDataPath.Join("boardID-upload-tool-missing-boards.txt")
+	projectData = projectdata.Initialize(testProject)
+	result, _ = BoardsTxtBoardIDUploadToolMissing(projectData)
+	assert.Equal(t, ruleresult.Fail, result)
+	assert.Equal(
+		t,
+		[]rulelocation.Type{
+			{Path: "boards.txt", Line: 5, Column: 1, Key: "buno.upload.tool"},
+			{Path: "boards.txt", Line: 20, Column: 1, Key: "funo.upload.tool"},
+		},
+		projectData.Locations(),
+		"Missing property should be located at its parent key",
+	)
+
+	testProject = project.Type{
+		Path:             testDataPath.Join("arduino.h-angle"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+	projectData = projectdata.Initialize(testProject)
+	result, _ = IncorrectArduinoDotHFileNameCase(projectData)
+	assert.Equal(t, ruleresult.Fail, result)
+	assert.Equal(t, []rulelocation.Type{{Path: "foo.h", Line: 1, Column: 1}}, projectData.Locations())
+
+	testProject = project.Type{
+		Path:             librariesTestDataPath.Join("DependsFieldMisspelled"),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+	projectData = projectdata.Initialize(testProject)
+	result, _ = LibraryPropertiesMisspelledOptionalField(projectData)
+	assert.Equal(t, ruleresult.Fail, result)
+	assert.Equal(t, []rulelocation.Type{{Path: "library.properties", Line: 10, Column: 1, Key: "depend"}}, projectData.Locations())
+
+	testProject.Path = librariesTestDataPath.Join("RecursiveWithUtilityFolder")
+	projectData = projectdata.Initialize(testProject)
+	result, _ = RecursiveLibraryWithUtilityFolder(projectData)
+	assert.Equal(t, ruleresult.Fail, result)
+	assert.Equal(t, []rulelocation.Type{{Path: "utility"}}, projectData.Locations())
+
+	result, _ = MissingExamples(projectData)
+	assert.Equal(t, ruleresult.Fail, result)
+	assert.Equal(t, []rulelocation.Type{{Path: "."}}, projectData.Locations(), "Problems with the project as a whole are located at the project folder")
+}
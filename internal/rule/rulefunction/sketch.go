@@ -27,10 +27,10 @@ import (
 )
 
 // SketchNameMismatch checks for mismatch between sketch folder name and primary file name.
-func SketchNameMismatch() (result ruleresult.Type, output string) {
-	primarySketchFilePrefix := projectdata.ProjectPath().Base()
+func SketchNameMismatch(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	primarySketchFilePrefix := projectData.ProjectPath().Base()
 
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -45,12 +45,13 @@ func SketchNameMismatch() (result ruleresult.Type, output string) {
 		}
 	}
 
-	return ruleresult.Fail, projectdata.ProjectPath().Base() + ".ino"
+	reportPathLocation(projectData, projectData.ProjectPath())
+	return ruleresult.Fail, projectData.ProjectPath().Base() + ".ino"
 }
 
 // ProhibitedCharactersInSketchFileName checks for prohibited characters in the sketch file names.
-func ProhibitedCharactersInSketchFileName() (result ruleresult.Type, output string) {
-	directoryListing, _ := projectdata.ProjectPath().ReadDir()
+func ProhibitedCharactersInSketchFileName(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, _ := projectData.ProjectPath().ReadDir()
 	directoryListing.FilterOutDirs()
 
 	foundInvalidSketchFileNames := []string{}
@@ -58,6 +59,7 @@ func ProhibitedCharactersInSketchFileName() (result ruleresult.Type, output stri
 		if sketch.HasSupportedExtension(potentialSketchFile) {
 			if !validProjectPathBaseName(potentialSketchFile.Base()) {
 				foundInvalidSketchFileNames = append(foundInvalidSketchFileNames, potentialSketchFile.Base())
+				reportPathLocation(projectData, potentialSketchFile)
 			}
 		}
 	}
@@ -70,8 +72,8 @@ func ProhibitedCharactersInSketchFileName() (result ruleresult.Type, output stri
 }
 
 // SketchFileNameGTMaxLength checks if the sketch file names exceed the maximum length.
-func SketchFileNameGTMaxLength() (result ruleresult.Type, output string) {
-	directoryListing, _ := projectdata.ProjectPath().ReadDir()
+func SketchFileNameGTMaxLength(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, _ := projectData.ProjectPath().ReadDir()
 	directoryListing.FilterOutDirs()
 
 	foundTooLongSketchFileNames := []string{}
@@ -79,6 +81,7 @@ func SketchFileNameGTMaxLength() (result ruleresult.Type, output string) {
 		if sketch.HasSupportedExtension(potentialSketchFile) {
 			if len(potentialSketchFile.Base())-len(potentialSketchFile.Ext()) > 63 {
 				foundTooLongSketchFileNames = append(foundTooLongSketchFileNames, potentialSketchFile.Base())
+				reportPathLocation(projectData, potentialSketchFile)
 			}
 		}
 	}
@@ -91,13 +94,14 @@ func SketchFileNameGTMaxLength() (result ruleresult.Type, output string) {
 }
 
 // PdeSketchExtension checks for use of deprecated .pde sketch file extensions.
-func PdeSketchExtension() (result ruleresult.Type, output string) {
-	directoryListing, _ := projectdata.ProjectPath().ReadDir()
+func PdeSketchExtension(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, _ := projectData.ProjectPath().ReadDir()
 	directoryListing.FilterOutDirs()
 	pdeSketches := []string{}
 	for _, filePath := range directoryListing {
 		if filePath.Ext() == ".pde" {
 			pdeSketches = append(pdeSketches, filePath.Base())
+			reportPathLocation(projectData, filePath)
 		}
 	}
 
@@ -109,8 +113,8 @@ func PdeSketchExtension() (result ruleresult.Type, output string) {
 }
 
 // IncorrectSketchSrcFolderNameCase checks for incorrect case of src subfolder name in recursive format libraries.
-func IncorrectSketchSrcFolderNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectSketchSrcFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -118,6 +122,7 @@ func IncorrectSketchSrcFolderNameCase() (result ruleresult.Type, output string)
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "src")
 	if found {
+		reportPathLocation(projectData, path)
 		return ruleresult.Fail, path.String()
 	}
 
@@ -125,8 +130,8 @@ func IncorrectSketchSrcFolderNameCase() (result ruleresult.Type, output string)
 }
 
 // SketchDotJSONJSONFormat checks whether the sketch.json metadata file is a valid JSON document.
-func SketchDotJSONJSONFormat() (result ruleresult.Type, output string) {
-	metadataPath := sketch.MetadataPath(projectdata.ProjectPath())
+func SketchDotJSONJSONFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	metadataPath := sketch.MetadataPath(projectData.ProjectPath())
 	if metadataPath == nil {
 		return ruleresult.Skip, "No metadata file"
 	}
@@ -135,19 +140,21 @@ func SketchDotJSONJSONFormat() (result ruleresult.Type, output string) {
 		return ruleresult.Pass, ""
 	}
 
+	reportPathLocation(projectData, metadataPath)
 	return ruleresult.Fail, ""
 }
 
 // SketchDotJSONFormat checks whether the sketch.json metadata file has the required data format.
-func SketchDotJSONFormat() (result ruleresult.Type, output string) {
-	metadataPath := sketch.MetadataPath(projectdata.ProjectPath())
+func SketchDotJSONFormat(projectData *projectdata.Type) (result ruleresult.Type, output string) {
+	metadataPath := sketch.MetadataPath(projectData.ProjectPath())
 	if metadataPath == nil {
 		return ruleresult.Skip, "No metadata file"
 	}
 
-	if projectdata.MetadataLoadError() == nil {
+	if projectData.MetadataLoadError() == nil {
 		return ruleresult.Pass, ""
 	}
 
-	return ruleresult.Fail, projectdata.MetadataLoadError().Error()
+	reportPathLocation(projectData, metadataPath)
+	return ruleresult.Fail, projectData.MetadataLoadError().Error()
 }
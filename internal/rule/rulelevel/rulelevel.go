@@ -49,6 +49,15 @@ func RuleLevel(ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.T
 
 // FailRuleLevel determines the level of a failed rule for the given rule modes.
 func FailRuleLevel(ruleConfiguration ruleconfiguration.Type, configurationRuleModes map[rulemode.Type]bool) (Type, error) {
+	// The configuration file takes precedence over the rule modes.
+	if levelOverride, ok := configuration.RuleLevelOverride(ruleConfiguration.ID); ok {
+		for _, level := range []Type{Info, Warning, Error} {
+			if level.String() == levelOverride {
+				return level, nil
+			}
+		}
+	}
+
 	for _, errorMode := range ruleConfiguration.ErrorModes {
 		if configurationRuleModes[errorMode] {
 			return Error, nil
@@ -57,7 +57,7 @@ func TestRuleLevel(t *testing.T) {
 		flags.Set("library-manager", testTable.libraryManagerSetting)
 		flags.Set("permissive", testTable.permissiveSetting)
 
-		configuration.Initialize(flags, []string{"/foo"})
+		configuration.Initialize(flags, []string{"."})
 
 		ruleConfiguration := ruleconfiguration.Type{
 			InfoModes:    testTable.infoModes,
@@ -76,3 +76,33 @@ func TestRuleLevel(t *testing.T) {
 		}
 	}
 }
+
+func TestFailRuleLevelConfigurationFile(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	flags.Set("config", "testdata/arduino-lint.yml")
+	configuration.Initialize(flags, []string{"."})
+
+	ruleConfiguration := ruleconfiguration.Type{
+		ID:         "XX001",
+		ErrorModes: []rulemode.Type{rulemode.Default},
+	}
+	level, err := FailRuleLevel(ruleConfiguration, configuration.RuleModes(projecttype.Library))
+	assert.NoError(t, err)
+	assert.Equal(t, Info, level, "Level overridden by configuration file")
+
+	ruleConfiguration = ruleconfiguration.Type{
+		ID:        "XX002",
+		InfoModes: []rulemode.Type{rulemode.Default},
+	}
+	level, err = FailRuleLevel(ruleConfiguration, configuration.RuleModes(projecttype.Library))
+	assert.NoError(t, err)
+	assert.Equal(t, Error, level, "Level overridden by configuration file")
+
+	ruleConfiguration = ruleconfiguration.Type{
+		ID:           "XX003",
+		WarningModes: []rulemode.Type{rulemode.Default},
+	}
+	level, err = FailRuleLevel(ruleConfiguration, configuration.RuleModes(projecttype.Library))
+	assert.NoError(t, err)
+	assert.Equal(t, Warning, level, "Level not overridden")
+}
@@ -0,0 +1,45 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package rulelocation defines the location in the project of a problem found by a rule.
+package rulelocation
+
+import (
+	"fmt"
+)
+
+// Type is the type for the locations of rule violations.
+type Type struct {
+	Path   string // Slash-separated path of the file or folder, relative to the project path.
+	Line   int    // 1-based line number. 0 when not applicable.
+	Column int    // 1-based column number. 0 when not applicable.
+	Key    string // Key of the property, for problems in a configuration or metadata file (e.g., `uno.build.core`).
+}
+
+// String returns the location in the conventional `path:line:column` format.
+func (location Type) String() string {
+	locationString := location.Path
+	if location.Line > 0 {
+		locationString += fmt.Sprintf(":%d", location.Line)
+		if location.Column > 0 {
+			locationString += fmt.Sprintf(":%d", location.Column)
+		}
+	}
+	if location.Key != "" {
+		locationString += fmt.Sprintf(" (%s)", location.Key)
+	}
+
+	return locationString
+}
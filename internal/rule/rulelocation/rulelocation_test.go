@@ -0,0 +1,38 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package rulelocation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestString(t *testing.T) {
+	testTables := []struct {
+		location       Type
+		expectedString string
+	}{
+		{Type{Path: "src"}, "src"},
+		{Type{Path: "src/foo.h", Line: 42}, "src/foo.h:42"},
+		{Type{Path: "src/foo.h", Line: 42, Column: 3}, "src/foo.h:42:3"},
+		{Type{Path: "boards.txt", Line: 7, Key: "uno.build.core"}, "boards.txt:7 (uno.build.core)"},
+	}
+
+	for _, testTable := range testTables {
+		assert.Equal(t, testTable.expectedString, testTable.location.String())
+	}
+}
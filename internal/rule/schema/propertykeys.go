@@ -0,0 +1,87 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package schema
+
+import (
+	"sort"
+	"strings"
+)
+
+// PatternKeySegment is used in the keys returned by PropertyKeys in place of the key segments which are defined by a
+// pattern rather than a name (e.g., the board ID of the boards.txt properties).
+const PatternKeySegment = "*"
+
+// maximumKeyDepth limits the depth of the nested properties returned by PropertyKeys, since schemas may be recursive.
+const maximumKeyDepth = 4
+
+// PropertyKeys returns the keys of the properties defined by the given schema, sorted. The keys of nested properties
+// are joined with a dot, as is done in the Arduino properties file format.
+func PropertyKeys(schemaFilename string, dataLoader dataLoaderType) []string {
+	keys := make(map[string]bool)
+	collectPropertyKeys(unmarshalJSONFile(schemaFilename, dataLoader), schemaFilename, "", 0, keys, dataLoader)
+
+	sortedKeys := []string{}
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	return sortedKeys
+}
+
+// collectPropertyKeys adds the keys of the properties defined by the given schema object to keys. schemaFilename is the
+// file the object is from, which is used to resolve references.
+func collectPropertyKeys(schemaObject interface{}, schemaFilename string, keyPrefix string, depth int, keys map[string]bool, dataLoader dataLoaderType) {
+	schemaMap, ok := schemaObject.(map[string]interface{})
+	if !ok || depth > maximumKeyDepth {
+		return
+	}
+
+	if reference, ok := schemaMap["$ref"].(string); ok {
+		referencedFilename := schemaFilename
+		pointer := "#"
+		if fragmentIndex := strings.Index(reference, "#"); fragmentIndex >= 0 {
+			if fragmentIndex > 0 {
+				referencedFilename = reference[:fragmentIndex]
+			}
+			pointer = reference[fragmentIndex:]
+		} else {
+			referencedFilename = reference
+		}
+		collectPropertyKeys(jsonPointerValue(pointer, referencedFilename, dataLoader), referencedFilename, keyPrefix, depth, keys, dataLoader)
+	}
+
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		if subschemas, ok := schemaMap[keyword].([]interface{}); ok {
+			for _, subschema := range subschemas {
+				collectPropertyKeys(subschema, schemaFilename, keyPrefix, depth, keys, dataLoader)
+			}
+		}
+	}
+
+	if properties, ok := schemaMap["properties"].(map[string]interface{}); ok {
+		for name, propertySchema := range properties {
+			keys[keyPrefix+name] = true
+			collectPropertyKeys(propertySchema, schemaFilename, keyPrefix+name+".", depth+1, keys, dataLoader)
+		}
+	}
+
+	if patternProperties, ok := schemaMap["patternProperties"].(map[string]interface{}); ok {
+		for _, propertySchema := range patternProperties {
+			collectPropertyKeys(propertySchema, schemaFilename, keyPrefix+PatternKeySegment+".", depth+1, keys, dataLoader)
+		}
+	}
+}
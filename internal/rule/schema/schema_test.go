@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/arduino/arduino-lint/internal/project/general"
+	"github.com/arduino/arduino-lint/internal/rule/schema/schemadata"
 	"github.com/arduino/arduino-lint/internal/rule/schema/testdata"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/ory/jsonschema/v3"
@@ -250,3 +251,8 @@ func Test_validationErrorContextMatch(t *testing.T) {
 	assert.True(t, validationErrorContextMatch(regexp.MustCompile("^#/bar$"), &validationError))
 	assert.False(t, validationErrorContextMatch(regexp.MustCompile("nomatch"), &validationError))
 }
+
+func TestPropertyKeys(t *testing.T) {
+	assert.Equal(t, []string{"property1", "property2", "property3"}, PropertyKeys("valid-schema-with-references.json", testdata.Asset))
+	assert.Equal(t, []string{"*.name", "*.program.tool"}, PropertyKeys("arduino-programmers-txt-schema.json", schemadata.Asset), "Nested and pattern properties")
+}
@@ -8,6 +8,10 @@
 // etc/schemas/arduino-library-properties-permissive-schema.json
 // etc/schemas/arduino-library-properties-schema.json
 // etc/schemas/arduino-library-properties-strict-schema.json
+// etc/schemas/arduino-package-index-definitions-schema.json
+// etc/schemas/arduino-package-index-permissive-schema.json
+// etc/schemas/arduino-package-index-schema.json
+// etc/schemas/arduino-package-index-strict-schema.json
 // etc/schemas/arduino-platform-txt-definitions-schema.json
 // etc/schemas/arduino-platform-txt-permissive-schema.json
 // etc/schemas/arduino-platform-txt-schema.json
@@ -2539,6 +2543,1491 @@ func arduinoLibraryPropertiesStrictSchemaJson() (*asset, error) {
 	return a, nil
 }
 
+var _arduinoPackageIndexDefinitionsSchemaJson = []byte(`{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "https://raw.githubusercontent.com/arduino/arduino-lint/main/etc/schemas/arduino-package-index-definitions-schema.json",
+  "title": "Shared definitions for the Arduino package index schemas",
+  "definitions": {
+    "propertiesObjects": {
+      "packages": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "array"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packages/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/package/permissive/object"
+                }
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packages/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/package/specification/object"
+                }
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packages/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/package/strict/object"
+                }
+              }
+            ]
+          }
+        }
+      },
+      "package": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "object"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/package/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/packageName/permissive/object"
+                  },
+                  "maintainer": {
+                    "$ref": "#/definitions/propertiesObjects/packageMaintainer/permissive/object"
+                  },
+                  "websiteURL": {
+                    "$ref": "#/definitions/propertiesObjects/packageWebsiteURL/permissive/object"
+                  },
+                  "email": {
+                    "$ref": "#/definitions/propertiesObjects/packageEmail/permissive/object"
+                  },
+                  "help": {
+                    "$ref": "#/definitions/propertiesObjects/help/permissive/object"
+                  },
+                  "platforms": {
+                    "$ref": "#/definitions/propertiesObjects/platforms/permissive/object"
+                  },
+                  "tools": {
+                    "$ref": "#/definitions/propertiesObjects/tools/permissive/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/package/permissive/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/package/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/packageName/specification/object"
+                  },
+                  "maintainer": {
+                    "$ref": "#/definitions/propertiesObjects/packageMaintainer/specification/object"
+                  },
+                  "websiteURL": {
+                    "$ref": "#/definitions/propertiesObjects/packageWebsiteURL/specification/object"
+                  },
+                  "email": {
+                    "$ref": "#/definitions/propertiesObjects/packageEmail/specification/object"
+                  },
+                  "help": {
+                    "$ref": "#/definitions/propertiesObjects/help/specification/object"
+                  },
+                  "platforms": {
+                    "$ref": "#/definitions/propertiesObjects/platforms/specification/object"
+                  },
+                  "tools": {
+                    "$ref": "#/definitions/propertiesObjects/tools/specification/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/package/specification/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/package/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/packageName/strict/object"
+                  },
+                  "maintainer": {
+                    "$ref": "#/definitions/propertiesObjects/packageMaintainer/strict/object"
+                  },
+                  "websiteURL": {
+                    "$ref": "#/definitions/propertiesObjects/packageWebsiteURL/strict/object"
+                  },
+                  "email": {
+                    "$ref": "#/definitions/propertiesObjects/packageEmail/strict/object"
+                  },
+                  "help": {
+                    "$ref": "#/definitions/propertiesObjects/help/strict/object"
+                  },
+                  "platforms": {
+                    "$ref": "#/definitions/propertiesObjects/platforms/strict/object"
+                  },
+                  "tools": {
+                    "$ref": "#/definitions/propertiesObjects/tools/strict/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/package/strict/object"
+              }
+            ]
+          }
+        }
+      },
+      "packageName": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              },
+              {
+                "minLength": 1
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageName/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageName/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageName/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "packageMaintainer": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              },
+              {
+                "minLength": 1
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageMaintainer/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageMaintainer/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageMaintainer/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "packageWebsiteURL": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageWebsiteURL/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageWebsiteURL/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageWebsiteURL/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "packageEmail": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageEmail/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageEmail/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/packageEmail/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "help": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "object"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/help/base/object"
+              },
+              {
+                "properties": {
+                  "online": {
+                    "$ref": "#/definitions/propertiesObjects/helpOnline/permissive/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/help/permissive/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/help/base/object"
+              },
+              {
+                "properties": {
+                  "online": {
+                    "$ref": "#/definitions/propertiesObjects/helpOnline/specification/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/help/specification/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/help/base/object"
+              },
+              {
+                "properties": {
+                  "online": {
+                    "$ref": "#/definitions/propertiesObjects/helpOnline/strict/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/help/strict/object"
+              }
+            ]
+          }
+        }
+      },
+      "helpOnline": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/helpOnline/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/helpOnline/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/helpOnline/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "platforms": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "array"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platforms/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/platform/permissive/object"
+                }
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platforms/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/platform/specification/object"
+                }
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platforms/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/platform/strict/object"
+                }
+              }
+            ]
+          }
+        }
+      },
+      "platform": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "object"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platform/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/platformName/permissive/object"
+                  },
+                  "architecture": {
+                    "$ref": "#/definitions/propertiesObjects/platformArchitecture/permissive/object"
+                  },
+                  "version": {
+                    "$ref": "#/definitions/propertiesObjects/version/permissive/object"
+                  },
+                  "category": {
+                    "$ref": "#/definitions/propertiesObjects/platformCategory/permissive/object"
+                  },
+                  "help": {
+                    "$ref": "#/definitions/propertiesObjects/help/permissive/object"
+                  },
+                  "boards": {
+                    "$ref": "#/definitions/propertiesObjects/platformBoards/permissive/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/platform/permissive/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platform/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/platformName/specification/object"
+                  },
+                  "architecture": {
+                    "$ref": "#/definitions/propertiesObjects/platformArchitecture/specification/object"
+                  },
+                  "version": {
+                    "$ref": "#/definitions/propertiesObjects/version/specification/object"
+                  },
+                  "category": {
+                    "$ref": "#/definitions/propertiesObjects/platformCategory/specification/object"
+                  },
+                  "help": {
+                    "$ref": "#/definitions/propertiesObjects/help/specification/object"
+                  },
+                  "boards": {
+                    "$ref": "#/definitions/propertiesObjects/platformBoards/specification/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/platform/specification/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platform/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/platformName/strict/object"
+                  },
+                  "architecture": {
+                    "$ref": "#/definitions/propertiesObjects/platformArchitecture/strict/object"
+                  },
+                  "version": {
+                    "$ref": "#/definitions/propertiesObjects/version/strict/object"
+                  },
+                  "category": {
+                    "$ref": "#/definitions/propertiesObjects/platformCategory/strict/object"
+                  },
+                  "help": {
+                    "$ref": "#/definitions/propertiesObjects/help/strict/object"
+                  },
+                  "boards": {
+                    "$ref": "#/definitions/propertiesObjects/platformBoards/strict/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/platform/strict/object"
+              }
+            ]
+          }
+        }
+      },
+      "platformName": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              },
+              {
+                "minLength": 1
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformName/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformName/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformName/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "platformArchitecture": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              },
+              {
+                "$comment": "The architecture is used as a folder name in the Boards Manager installation path.",
+                "pattern": "^[a-zA-Z0-9_.-]+$"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformArchitecture/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformArchitecture/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformArchitecture/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "version": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              },
+              {
+                "minLength": 1
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/version/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/version/base/object"
+              },
+              {
+                "$ref": "general-definitions-schema.json#/definitions/patternObjects/relaxedSemver"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/version/base/object"
+              },
+              {
+                "$ref": "general-definitions-schema.json#/definitions/patternObjects/semver"
+              }
+            ]
+          }
+        }
+      },
+      "platformCategory": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformCategory/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformCategory/base/object"
+              },
+              {
+                "enum": ["Arduino", "Arduino Certified", "Contributed", "ESP8266", "Partner"]
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformCategory/base/object"
+              },
+              {
+                "enum": ["Arduino", "Arduino Certified", "Contributed", "ESP8266", "Partner"]
+              }
+            ]
+          }
+        }
+      },
+      "platformBoards": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "array"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformBoards/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/board/permissive/object"
+                }
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformBoards/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/board/specification/object"
+                }
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/platformBoards/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/board/strict/object"
+                }
+              },
+              {
+                "minItems": 1
+              }
+            ]
+          }
+        }
+      },
+      "tools": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "array"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/tools/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/tool/permissive/object"
+                }
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/tools/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/tool/specification/object"
+                }
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/tools/base/object"
+              },
+              {
+                "items": {
+                  "$ref": "#/definitions/propertiesObjects/tool/strict/object"
+                }
+              }
+            ]
+          }
+        }
+      },
+      "tool": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "object"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/tool/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/toolName/permissive/object"
+                  },
+                  "version": {
+                    "$ref": "#/definitions/propertiesObjects/version/permissive/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/tool/permissive/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/tool/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/toolName/specification/object"
+                  },
+                  "version": {
+                    "$ref": "#/definitions/propertiesObjects/version/specification/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/tool/specification/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/tool/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/toolName/strict/object"
+                  },
+                  "version": {
+                    "$ref": "#/definitions/propertiesObjects/version/strict/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/tool/strict/object"
+              }
+            ]
+          }
+        }
+      },
+      "toolName": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              },
+              {
+                "minLength": 1
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/toolName/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/toolName/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/toolName/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "board": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "object"
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/board/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/boardName/permissive/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/board/permissive/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/board/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/boardName/specification/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/board/specification/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/board/base/object"
+              },
+              {
+                "properties": {
+                  "name": {
+                    "$ref": "#/definitions/propertiesObjects/boardName/strict/object"
+                  }
+                }
+              },
+              {
+                "$ref": "#/definitions/requiredObjects/board/strict/object"
+              }
+            ]
+          }
+        }
+      },
+      "boardName": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "type": "string"
+              },
+              {
+                "minLength": 1
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/boardName/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/boardName/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/propertiesObjects/boardName/base/object"
+              }
+            ]
+          }
+        }
+      }
+    },
+    "requiredObjects": {
+      "root": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "required": ["packages"]
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/root/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/root/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/root/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "package": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "required": ["name", "maintainer", "platforms"]
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/package/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/package/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/package/base/object"
+              },
+              {
+                "required": ["websiteURL", "help"]
+              }
+            ]
+          }
+        }
+      },
+      "help": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "required": ["online"]
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/help/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/help/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/help/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "platform": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "required": ["name", "architecture", "version", "category"]
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/platform/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/platform/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/platform/base/object"
+              },
+              {
+                "required": ["boards"]
+              }
+            ]
+          }
+        }
+      },
+      "board": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "required": ["name"]
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/board/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/board/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/board/base/object"
+              }
+            ]
+          }
+        }
+      },
+      "tool": {
+        "base": {
+          "object": {
+            "allOf": [
+              {
+                "required": ["name", "version", "systems"]
+              }
+            ]
+          }
+        },
+        "permissive": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/tool/base/object"
+              }
+            ]
+          }
+        },
+        "specification": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/tool/base/object"
+              }
+            ]
+          }
+        },
+        "strict": {
+          "object": {
+            "allOf": [
+              {
+                "$ref": "#/definitions/requiredObjects/tool/base/object"
+              }
+            ]
+          }
+        }
+      }
+    }
+  }
+}
+`)
+
+func arduinoPackageIndexDefinitionsSchemaJsonBytes() ([]byte, error) {
+	return _arduinoPackageIndexDefinitionsSchemaJson, nil
+}
+
+func arduinoPackageIndexDefinitionsSchemaJson() (*asset, error) {
+	bytes, err := arduinoPackageIndexDefinitionsSchemaJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "arduino-package-index-definitions-schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _arduinoPackageIndexPermissiveSchemaJson = []byte(`{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "https://raw.githubusercontent.com/arduino/arduino-lint/main/etc/schemas/arduino-package-index-permissive-schema.json",
+  "title": "Arduino package index JSON permissive schema",
+  "description": "Package indexes define the boards platforms and tools available for installation via the Arduino Boards Manager. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/",
+  "type": "object",
+  "allOf": [
+    {
+      "properties": {
+        "packages": {
+          "$ref": "arduino-package-index-definitions-schema.json#/definitions/propertiesObjects/packages/permissive/object"
+        }
+      }
+    },
+    {
+      "$ref": "arduino-package-index-definitions-schema.json#/definitions/requiredObjects/root/permissive/object"
+    }
+  ]
+}
+`)
+
+func arduinoPackageIndexPermissiveSchemaJsonBytes() ([]byte, error) {
+	return _arduinoPackageIndexPermissiveSchemaJson, nil
+}
+
+func arduinoPackageIndexPermissiveSchemaJson() (*asset, error) {
+	bytes, err := arduinoPackageIndexPermissiveSchemaJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "arduino-package-index-permissive-schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _arduinoPackageIndexSchemaJson = []byte(`{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "https://raw.githubusercontent.com/arduino/arduino-lint/main/etc/schemas/arduino-package-index-schema.json",
+  "title": "Arduino package index JSON schema",
+  "description": "Package indexes define the boards platforms and tools available for installation via the Arduino Boards Manager. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/",
+  "type": "object",
+  "allOf": [
+    {
+      "properties": {
+        "packages": {
+          "$ref": "arduino-package-index-definitions-schema.json#/definitions/propertiesObjects/packages/specification/object"
+        }
+      }
+    },
+    {
+      "$ref": "arduino-package-index-definitions-schema.json#/definitions/requiredObjects/root/specification/object"
+    }
+  ]
+}
+`)
+
+func arduinoPackageIndexSchemaJsonBytes() ([]byte, error) {
+	return _arduinoPackageIndexSchemaJson, nil
+}
+
+func arduinoPackageIndexSchemaJson() (*asset, error) {
+	bytes, err := arduinoPackageIndexSchemaJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "arduino-package-index-schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _arduinoPackageIndexStrictSchemaJson = []byte(`{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "https://raw.githubusercontent.com/arduino/arduino-lint/main/etc/schemas/arduino-package-index-strict-schema.json",
+  "title": "Arduino package index JSON strict schema",
+  "description": "Package indexes define the boards platforms and tools available for installation via the Arduino Boards Manager. See: https://arduino.github.io/arduino-cli/latest/package_index_json-specification/",
+  "type": "object",
+  "allOf": [
+    {
+      "properties": {
+        "packages": {
+          "$ref": "arduino-package-index-definitions-schema.json#/definitions/propertiesObjects/packages/strict/object"
+        }
+      }
+    },
+    {
+      "$ref": "arduino-package-index-definitions-schema.json#/definitions/requiredObjects/root/strict/object"
+    }
+  ]
+}
+`)
+
+func arduinoPackageIndexStrictSchemaJsonBytes() ([]byte, error) {
+	return _arduinoPackageIndexStrictSchemaJson, nil
+}
+
+func arduinoPackageIndexStrictSchemaJson() (*asset, error) {
+	bytes, err := arduinoPackageIndexStrictSchemaJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "arduino-package-index-strict-schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _arduinoPlatformTxtDefinitionsSchemaJson = []byte(`{
   "$schema": "http://json-schema.org/draft-07/schema#",
   "$id": "https://raw.githubusercontent.com/arduino/arduino-lint/main/etc/schemas/arduino-platform-txt-definitions-schema.json",
@@ -4455,6 +5944,10 @@ var _bindata = map[string]func() (*asset, error){
 	"arduino-library-properties-permissive-schema.json":  arduinoLibraryPropertiesPermissiveSchemaJson,
 	"arduino-library-properties-schema.json":             arduinoLibraryPropertiesSchemaJson,
 	"arduino-library-properties-strict-schema.json":      arduinoLibraryPropertiesStrictSchemaJson,
+	"arduino-package-index-definitions-schema.json":      arduinoPackageIndexDefinitionsSchemaJson,
+	"arduino-package-index-permissive-schema.json":       arduinoPackageIndexPermissiveSchemaJson,
+	"arduino-package-index-schema.json":                  arduinoPackageIndexSchemaJson,
+	"arduino-package-index-strict-schema.json":           arduinoPackageIndexStrictSchemaJson,
 	"arduino-platform-txt-definitions-schema.json":       arduinoPlatformTxtDefinitionsSchemaJson,
 	"arduino-platform-txt-permissive-schema.json":        arduinoPlatformTxtPermissiveSchemaJson,
 	"arduino-platform-txt-schema.json":                   arduinoPlatformTxtSchemaJson,
@@ -4515,6 +6008,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"arduino-library-properties-permissive-schema.json":  &bintree{arduinoLibraryPropertiesPermissiveSchemaJson, map[string]*bintree{}},
 	"arduino-library-properties-schema.json":             &bintree{arduinoLibraryPropertiesSchemaJson, map[string]*bintree{}},
 	"arduino-library-properties-strict-schema.json":      &bintree{arduinoLibraryPropertiesStrictSchemaJson, map[string]*bintree{}},
+	"arduino-package-index-definitions-schema.json":      &bintree{arduinoPackageIndexDefinitionsSchemaJson, map[string]*bintree{}},
+	"arduino-package-index-permissive-schema.json":       &bintree{arduinoPackageIndexPermissiveSchemaJson, map[string]*bintree{}},
+	"arduino-package-index-schema.json":                  &bintree{arduinoPackageIndexSchemaJson, map[string]*bintree{}},
+	"arduino-package-index-strict-schema.json":           &bintree{arduinoPackageIndexStrictSchemaJson, map[string]*bintree{}},
 	"arduino-platform-txt-definitions-schema.json":       &bintree{arduinoPlatformTxtDefinitionsSchemaJson, map[string]*bintree{}},
 	"arduino-platform-txt-permissive-schema.json":        &bintree{arduinoPlatformTxtPermissiveSchemaJson, map[string]*bintree{}},
 	"arduino-platform-txt-schema.json":                   &bintree{arduinoPlatformTxtSchemaJson, map[string]*bintree{}},
@@ -0,0 +1 @@
+#include <arduino.h>
@@ -0,0 +1,192 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package diff generates unified diffs of text files.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around each change.
+const contextLines = 3
+
+// operationType is the type for the operations of an edit script.
+type operationType int
+
+const (
+	equal operationType = iota
+	remove
+	add
+)
+
+// editType is the type for the steps of an edit script that converts the old lines to the new lines.
+type editType struct {
+	operation operationType
+	oldIndex  int
+	newIndex  int
+}
+
+// Unified returns the unified diff between the old and new content of the file at the given slash-separated path.
+// An empty string is returned if the contents are the same.
+func Unified(path string, oldData []byte, newData []byte) string {
+	if string(oldData) == string(newData) {
+		return ""
+	}
+
+	oldLines := splitLines(string(oldData))
+	newLines := splitLines(string(newData))
+	edits := editScript(oldLines, newLines)
+
+	var diff strings.Builder
+	fmt.Fprintf(&diff, "--- a/%s\n+++ b/%s\n", path, path)
+
+	for hunkStart := 0; hunkStart < len(edits); {
+		// Find the next change.
+		for hunkStart < len(edits) && edits[hunkStart].operation == equal {
+			hunkStart++
+		}
+		if hunkStart == len(edits) {
+			break
+		}
+
+		// Extend the hunk until there are enough unchanged lines to separate it from the next change.
+		hunkEnd := hunkStart
+		for unchangedCount := 0; hunkEnd < len(edits) && unchangedCount <= 2*contextLines; hunkEnd++ {
+			if edits[hunkEnd].operation == equal {
+				unchangedCount++
+			} else {
+				unchangedCount = 0
+			}
+		}
+		for hunkEnd > hunkStart && edits[hunkEnd-1].operation == equal {
+			hunkEnd--
+		}
+
+		// Add the context.
+		hunkStart -= contextLines
+		if hunkStart < 0 {
+			hunkStart = 0
+		}
+		hunkEnd += contextLines
+		if hunkEnd > len(edits) {
+			hunkEnd = len(edits)
+		}
+
+		writeHunk(&diff, edits[hunkStart:hunkEnd], oldLines, newLines)
+		hunkStart = hunkEnd
+	}
+
+	return diff.String()
+}
+
+// writeHunk writes the hunk of the given edits to the diff.
+func writeHunk(diff *strings.Builder, edits []editType, oldLines []string, newLines []string) {
+	oldStart, newStart := edits[0].oldIndex, edits[0].newIndex
+	oldCount, newCount := 0, 0
+	for _, edit := range edits {
+		if edit.operation != add {
+			oldCount++
+		}
+		if edit.operation != remove {
+			newCount++
+		}
+	}
+
+	fmt.Fprintf(diff, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+	for _, edit := range edits {
+		switch edit.operation {
+		case equal:
+			writeLine(diff, " ", oldLines[edit.oldIndex])
+		case remove:
+			writeLine(diff, "-", oldLines[edit.oldIndex])
+		case add:
+			writeLine(diff, "+", newLines[edit.newIndex])
+		}
+	}
+}
+
+// hunkRange returns the range of a hunk header, given the zero-based index of its first line.
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		// An empty range refers to the line before the change.
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// writeLine writes the line to the diff with the given prefix.
+func writeLine(diff *strings.Builder, prefix string, line string) {
+	diff.WriteString(prefix)
+	diff.WriteString(line)
+	if !strings.HasSuffix(line, "\n") {
+		diff.WriteString("\n\\ No newline at end of file\n")
+	}
+}
+
+// splitLines splits the data into lines, retaining the line terminators.
+func splitLines(data string) []string {
+	lines := strings.SplitAfter(data, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// editScript returns the shortest edit script that converts the old lines to the new lines, based on their longest common
+// subsequence.
+func editScript(oldLines []string, newLines []string) []editType {
+	// commonLength[oldIndex][newIndex] is the length of the longest common subsequence of oldLines[oldIndex:] and newLines[newIndex:].
+	commonLength := make([][]int, len(oldLines)+1)
+	for oldIndex := range commonLength {
+		commonLength[oldIndex] = make([]int, len(newLines)+1)
+	}
+	for oldIndex := len(oldLines) - 1; oldIndex >= 0; oldIndex-- {
+		for newIndex := len(newLines) - 1; newIndex >= 0; newIndex-- {
+			if oldLines[oldIndex] == newLines[newIndex] {
+				commonLength[oldIndex][newIndex] = commonLength[oldIndex+1][newIndex+1] + 1
+			} else if commonLength[oldIndex+1][newIndex] >= commonLength[oldIndex][newIndex+1] {
+				commonLength[oldIndex][newIndex] = commonLength[oldIndex+1][newIndex]
+			} else {
+				commonLength[oldIndex][newIndex] = commonLength[oldIndex][newIndex+1]
+			}
+		}
+	}
+
+	edits := []editType{}
+	oldIndex, newIndex := 0, 0
+	for oldIndex < len(oldLines) || newIndex < len(newLines) {
+		switch {
+		case oldIndex < len(oldLines) && newIndex < len(newLines) && oldLines[oldIndex] == newLines[newIndex]:
+			edits = append(edits, editType{operation: equal, oldIndex: oldIndex, newIndex: newIndex})
+			oldIndex++
+			newIndex++
+		case newIndex == len(newLines) || (oldIndex < len(oldLines) && commonLength[oldIndex+1][newIndex] >= commonLength[oldIndex][newIndex+1]):
+			edits = append(edits, editType{operation: remove, oldIndex: oldIndex, newIndex: newIndex})
+			oldIndex++
+		default:
+			edits = append(edits, editType{operation: add, oldIndex: oldIndex, newIndex: newIndex})
+			newIndex++
+		}
+	}
+
+	return edits
+}
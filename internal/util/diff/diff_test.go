@@ -0,0 +1,59 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnified(t *testing.T) {
+	assert.Equal(t, "", Unified("foo.txt", []byte("a\nb\n"), []byte("a\nb\n")), "No changes")
+
+	assert.Equal(t,
+		"--- a/foo.txt\n+++ b/foo.txt\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		Unified("foo.txt", []byte("a\nb\nc\n"), []byte("a\nB\nc\n")),
+		"Changed line",
+	)
+
+	assert.Equal(t,
+		"--- a/foo.txt\n+++ b/foo.txt\n@@ -0,0 +1 @@\n+a\n",
+		Unified("foo.txt", []byte(""), []byte("a\n")),
+		"Added to empty file",
+	)
+
+	assert.Equal(t,
+		"--- a/foo.txt\n+++ b/foo.txt\n@@ -1 +1 @@\n-a\n\\ No newline at end of file\n+b\n\\ No newline at end of file\n",
+		Unified("foo.txt", []byte("a"), []byte("b")),
+		"No newline at end of file",
+	)
+
+	oldData := []byte("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n")
+	newData := []byte("1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\nnineteen\n20\n")
+	assert.Equal(t,
+		"--- a/foo.txt\n+++ b/foo.txt\n@@ -1,6 +1,6 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n@@ -16,5 +16,5 @@\n 16\n 17\n 18\n-19\n+nineteen\n 20\n",
+		Unified("foo.txt", oldData, newData),
+		"Separate hunks",
+	)
+
+	newData = []byte("1\n2\nthree\n4\n5\n6\n7\n8\nnine\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n")
+	assert.Equal(t,
+		"--- a/foo.txt\n+++ b/foo.txt\n@@ -1,12 +1,12 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n 7\n 8\n-9\n+nine\n 10\n 11\n 12\n",
+		Unified("foo.txt", oldData, newData),
+		"Merged hunks",
+	)
+}
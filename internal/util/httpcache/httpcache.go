@@ -0,0 +1,242 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package httpcache provides an on-disk cache for HTTP downloads and URL checks.
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// downloadMetadataType is the type for the data used to revalidate a cached download.
+type downloadMetadataType struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// Get returns the body of the resource at the given URL.
+// If the cache contains a copy of the resource, the server is asked to only send the resource if it was modified since it was cached.
+// If cachePath is nil, the resource is downloaded without caching.
+func Get(cachePath *paths.Path, url string) ([]byte, error) {
+	if cachePath == nil {
+		return download(url)
+	}
+
+	bodyPath, metadataPath := downloadPaths(cachePath, url)
+	var metadata downloadMetadataType
+	if metadataData, err := metadataPath.ReadFile(); err == nil && bodyPath.Exist() {
+		if err := json.Unmarshal(metadataData, &metadata); err != nil {
+			metadata = downloadMetadataType{}
+		}
+	}
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.ETag != "" {
+		request.Header.Set("If-None-Match", metadata.ETag)
+	}
+	if metadata.LastModified != "" {
+		request.Header.Set("If-Modified-Since", metadata.LastModified)
+	}
+
+	httpResponse, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode == http.StatusNotModified && metadata.URL != "" {
+		logrus.Debugf("Using cached copy of %s", url)
+		return bodyPath.ReadFile()
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Server responded with %s", httpResponse.Status)
+	}
+
+	body, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata = downloadMetadataType{
+		URL:          url,
+		ETag:         httpResponse.Header.Get("ETag"),
+		LastModified: httpResponse.Header.Get("Last-Modified"),
+	}
+	if metadata.ETag != "" || metadata.LastModified != "" {
+		// Failure to cache is not fatal.
+		if err := writeDownload(bodyPath, metadataPath, body, metadata); err != nil {
+			logrus.Warnf("Unable to cache download of %s: %s", url, err)
+		}
+	}
+
+	return body, nil
+}
+
+// Cached returns the cached copy of the resource at the given URL, without accessing the network.
+func Cached(cachePath *paths.Path, url string) ([]byte, error) {
+	if cachePath == nil {
+		return nil, errors.New("Cache disabled")
+	}
+
+	bodyPath, metadataPath := downloadPaths(cachePath, url)
+	if !metadataPath.Exist() {
+		return nil, fmt.Errorf("No cached copy of %s", url)
+	}
+	return bodyPath.ReadFile()
+}
+
+// download returns the body of the resource at the given URL.
+func download(url string) ([]byte, error) {
+	httpResponse, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Server responded with %s", httpResponse.Status)
+	}
+
+	return ioutil.ReadAll(httpResponse.Body)
+}
+
+// downloadPaths returns the paths of the cached body and metadata files for the given URL.
+func downloadPaths(cachePath *paths.Path, url string) (*paths.Path, *paths.Path) {
+	hash := sha256.Sum256([]byte(url))
+	baseName := hex.EncodeToString(hash[:])
+	downloadsPath := cachePath.Join("downloads")
+	return downloadsPath.Join(baseName + ".body"), downloadsPath.Join(baseName + ".json")
+}
+
+// writeDownload stores a downloaded resource in the cache.
+func writeDownload(bodyPath *paths.Path, metadataPath *paths.Path, body []byte, metadata downloadMetadataType) error {
+	if err := bodyPath.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	if err := bodyPath.WriteFile(body); err != nil {
+		return err
+	}
+	metadataData, err := json.Marshal(metadata)
+	if err != nil {
+		panic(err)
+	}
+	return metadataPath.WriteFile(metadataData) // The metadata is written last so it is only present for a complete body.
+}
+
+// URLCheckResultType is the type for the result of checking whether a URL can be loaded.
+type URLCheckResultType struct {
+	Time       time.Time `json:"time"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Status     string    `json:"status,omitempty"`
+	Error      string    `json:"error,omitempty"` // The error from the request, if it failed.
+}
+
+// urlCheckCacheMutex protects the URL check cache file from concurrent access.
+var urlCheckCacheMutex sync.Mutex
+
+// CheckURL requests the given URL and returns the result.
+// Definitive results are cached for the given time to live. If cachePath is nil, the result is not cached.
+func CheckURL(cachePath *paths.Path, url string, timeToLive time.Duration) URLCheckResultType {
+	if cachePath != nil {
+		urlCheckCacheMutex.Lock()
+		cachedResult, ok := readURLCheckCache(cachePath)[url]
+		urlCheckCacheMutex.Unlock()
+		if ok && time.Since(cachedResult.Time) < timeToLive {
+			logrus.Debugf("Using cached check result for %s", url)
+			return cachedResult
+		}
+	}
+
+	result := URLCheckResultType{Time: time.Now()}
+	httpResponse, err := http.Get(url)
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		httpResponse.Body.Close()
+		result.StatusCode = httpResponse.StatusCode
+		result.Status = httpResponse.Status
+	}
+
+	if cachePath != nil && result.definitive() {
+		urlCheckCacheMutex.Lock()
+		defer urlCheckCacheMutex.Unlock()
+		urlCheckCache := readURLCheckCache(cachePath)
+		urlCheckCache[url] = result
+		if err := writeURLCheckCache(cachePath, urlCheckCache); err != nil {
+			logrus.Warnf("Unable to cache check result of %s: %s", url, err)
+		}
+	}
+
+	return result
+}
+
+// definitive returns whether the result is not expected to change on a retry, so it can be cached.
+// Failed requests, server errors, and rate limits may be transient, so only success and the "not found" statuses are
+// definitive.
+func (result URLCheckResultType) definitive() bool {
+	if result.Error != "" {
+		return false
+	}
+
+	return (result.StatusCode >= 200 && result.StatusCode < 300) ||
+		result.StatusCode == http.StatusNotFound ||
+		result.StatusCode == http.StatusGone
+}
+
+// urlCheckCachePath returns the path of the URL check cache file.
+func urlCheckCachePath(cachePath *paths.Path) *paths.Path {
+	return cachePath.Join("urlcheck.json")
+}
+
+// readURLCheckCache returns the contents of the URL check cache file.
+func readURLCheckCache(cachePath *paths.Path) map[string]URLCheckResultType {
+	urlCheckCache := make(map[string]URLCheckResultType)
+	urlCheckCacheData, err := urlCheckCachePath(cachePath).ReadFile()
+	if err != nil {
+		return urlCheckCache
+	}
+	if err := json.Unmarshal(urlCheckCacheData, &urlCheckCache); err != nil {
+		logrus.Warnf("Ignoring corrupted URL check cache: %s", err)
+		return make(map[string]URLCheckResultType)
+	}
+
+	return urlCheckCache
+}
+
+// writeURLCheckCache writes the URL check cache file.
+func writeURLCheckCache(cachePath *paths.Path, urlCheckCache map[string]URLCheckResultType) error {
+	if err := cachePath.MkdirAll(); err != nil {
+		return err
+	}
+	urlCheckCacheData, err := json.MarshalIndent(urlCheckCache, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return urlCheckCachePath(cachePath).WriteFile(urlCheckCacheData)
+}
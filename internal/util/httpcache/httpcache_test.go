@@ -0,0 +1,150 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package httpcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	requestCount := 0
+	notModifiedCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestCount++
+		if request.Header.Get("If-None-Match") == `"foo"` {
+			notModifiedCount++
+			writer.WriteHeader(http.StatusNotModified)
+			return
+		}
+		writer.Header().Set("ETag", `"foo"`)
+		writer.Write([]byte("bar"))
+	}))
+	defer server.Close()
+
+	cachePath, err := paths.MkTempDir("", "arduino-lint-httpcache")
+	require.Nil(t, err)
+	defer cachePath.RemoveAll()
+
+	_, err = Cached(cachePath, server.URL)
+	assert.Error(t, err, "Not cached yet")
+
+	body, err := Get(cachePath, server.URL)
+	require.Nil(t, err)
+	assert.Equal(t, "bar", string(body))
+	assert.Equal(t, 0, notModifiedCount)
+
+	body, err = Get(cachePath, server.URL)
+	require.Nil(t, err)
+	assert.Equal(t, "bar", string(body), "Cached copy used")
+	assert.Equal(t, 1, notModifiedCount, "Cached copy revalidated")
+
+	body, err = Cached(cachePath, server.URL)
+	require.Nil(t, err)
+	assert.Equal(t, "bar", string(body))
+
+	body, err = Get(nil, server.URL)
+	require.Nil(t, err)
+	assert.Equal(t, "bar", string(body), "Cache disabled")
+	assert.Equal(t, 3, requestCount)
+
+	_, err = Cached(nil, server.URL)
+	assert.Error(t, err, "Cache disabled")
+}
+
+func TestGetError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cachePath, err := paths.MkTempDir("", "arduino-lint-httpcache")
+	require.Nil(t, err)
+	defer cachePath.RemoveAll()
+
+	_, err = Get(cachePath, server.URL)
+	assert.Error(t, err)
+	_, err = Get(nil, server.URL)
+	assert.Error(t, err)
+}
+
+func TestCheckURL(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestCount++
+		switch request.URL.Path {
+		case "/notfound":
+			writer.WriteHeader(http.StatusNotFound)
+		case "/unavailable":
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		case "/ratelimited":
+			writer.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	cachePath, err := paths.MkTempDir("", "arduino-lint-httpcache")
+	require.Nil(t, err)
+	defer cachePath.RemoveAll()
+
+	result := CheckURL(cachePath, server.URL, time.Hour)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, "", result.Error)
+	assert.Equal(t, 1, requestCount)
+
+	result = CheckURL(cachePath, server.URL, time.Hour)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 1, requestCount, "Cached result used")
+
+	result = CheckURL(cachePath, server.URL, 0)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 2, requestCount, "Expired result not used")
+
+	result = CheckURL(cachePath, server.URL+"/notfound", time.Hour)
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+	assert.Equal(t, "404 Not Found", result.Status)
+
+	result = CheckURL(cachePath, server.URL+"/notfound", time.Hour)
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+	assert.Equal(t, 3, requestCount, "Cached not found result used")
+
+	result = CheckURL(nil, server.URL, time.Hour)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 4, requestCount, "Cache disabled")
+
+	for _, path := range []string{"/unavailable", "/ratelimited"} {
+		requestCount = 0
+		CheckURL(cachePath, server.URL+path, time.Hour)
+		result = CheckURL(cachePath, server.URL+path, time.Hour)
+		assert.Equal(t, 2, requestCount, "Transient failure of %s not cached", path)
+	}
+
+	// A request that fails is retried on the next check.
+	failingURL := "http://127.0.0.1:1/"
+	result = CheckURL(cachePath, failingURL, time.Hour)
+	assert.NotEqual(t, "", result.Error)
+	_, cached := readURLCheckCache(cachePath)[failingURL]
+	assert.False(t, cached, "Failed check not cached")
+
+	result = CheckURL(nil, "http://invalid.invalid:foo", time.Hour)
+	assert.NotEqual(t, "", result.Error)
+}
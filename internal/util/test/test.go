@@ -21,16 +21,34 @@ import "github.com/spf13/pflag"
 // ConfigurationFlags returns a set of the flags used for command line configuration of arduino-lint.
 func ConfigurationFlags() *pflag.FlagSet {
 	flags := pflag.NewFlagSet("", pflag.ExitOnError)
+	flags.String("archives-dir", "", "")
+	flags.String("baseline", "", "")
+	flags.String("cache-dir", "", "")
+	flags.StringSlice("category", nil, "")
 	flags.String("compliance", "specification", "")
+	flags.String("config", "", "")
+	flags.Bool("dry-run", false, "")
+	flags.String("fail-on", "error", "")
+	flags.Bool("fix", false, "")
 	flags.String("format", "text", "")
+	flags.Int("jobs", 0, "")
+	flags.String("library-index", "", "")
 	flags.String("library-manager", "", "")
 	flags.String("log-format", "text", "")
 	flags.String("log-level", "panic", "")
+	flags.Int("max-warnings", -1, "")
+	flags.Bool("no-cache", true, "") // Tests must not depend on the state of the user's cache.
+	flags.Bool("offline", false, "")
+	flags.String("package-index", "", "")
 	flags.String("project-type", "all", "")
 	flags.Bool("recursive", true, "")
 	flags.String("report-file", "", "")
+	flags.StringSlice("rules", nil, "")
+	flags.StringSlice("skip-rules", nil, "")
 	flags.Bool("verbose", false, "")
 	flags.Bool("version", false, "")
+	flags.Bool("watch", false, "")
+	flags.String("write-baseline", "", "")
 
 	return flags
 }
@@ -0,0 +1,209 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package watch re-lints projects when their files change.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/result"
+	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/arduino/arduino-lint/internal/rule"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fsnotify/fsnotify"
+)
+
+// debounceDelay is how long to wait for further changes before re-linting, so that a burst of changes (e.g., saving
+// several files at once) causes a single re-lint.
+const debounceDelay = 200 * time.Millisecond
+
+// Projects watches the files of the given projects, which must already have been linted, and re-lints each project
+// whose files change, printing the changes to its findings. It returns when the context is canceled.
+func Projects(ctx context.Context, projects []project.Type) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("Unable to watch project files: %v", err)
+	}
+	defer watcher.Close()
+
+	findings := make([][]string, len(projects))
+	for index, watchedProject := range projects {
+		findings[index] = result.Results.Findings(watchedProject)
+
+		watchPath := watchedProject.Path
+		if watchPath.IsNotDir() {
+			// Package index projects are files.
+			watchPath = watchPath.Parent()
+		}
+		if err := addWatches(watcher, watchPath); err != nil {
+			return err
+		}
+	}
+
+	feedback.Print("Watching for changes. Press Ctrl+C to stop.\n")
+
+	var changedPaths paths.PathList
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			eventPath := paths.New(event.Name)
+			if event.Op&fsnotify.Create != 0 && eventPath.IsDir() {
+				if err := addWatches(watcher, eventPath); err != nil {
+					return err
+				}
+			}
+			changedPaths.AddIfMissing(eventPath)
+			debounce = time.After(debounceDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return fmt.Errorf("Error while watching project files: %v", err)
+		case <-debounce:
+			for _, index := range affectedProjects(projects, changedPaths) {
+				newFindings, err := lint(projects[index])
+				if err != nil {
+					return err
+				}
+				feedback.Print(diffText(projects[index], findings[index], newFindings))
+				findings[index] = newFindings
+			}
+			changedPaths = nil
+		}
+	}
+}
+
+// addWatches adds watches for the given folder and all its subfolders.
+func addWatches(watcher *fsnotify.Watcher, folderPath *paths.Path) error {
+	folderPath, err := folderPath.Abs()
+	if err != nil {
+		return err
+	}
+
+	folders := paths.PathList{folderPath}
+	subfolders, err := folderPath.ReadDirRecursive()
+	if err != nil {
+		return fmt.Errorf("Unable to watch %s: %v", folderPath, err)
+	}
+	subfolders.FilterDirs()
+	folders.AddAllMissing(subfolders)
+
+	for _, folder := range folders {
+		if strings.Contains(filepath.ToSlash(folder.String())+"/", "/.git/") {
+			continue
+		}
+		if err := watcher.Add(folder.String()); err != nil {
+			return fmt.Errorf("Unable to watch %s: %v", folder, err)
+		}
+	}
+
+	return nil
+}
+
+// affectedProjects returns the indexes of the projects that contain any of the changed paths.
+func affectedProjects(projects []project.Type, changedPaths paths.PathList) []int {
+	indexes := []int{}
+	for index, watchedProject := range projects {
+		projectPath, err := watchedProject.Path.Abs()
+		if err != nil {
+			panic(err)
+		}
+		for _, changedPath := range changedPaths {
+			changedPath, err := changedPath.Abs()
+			if err != nil {
+				panic(err)
+			}
+			if changedPath.EquivalentTo(projectPath) {
+				indexes = append(indexes, index)
+				break
+			}
+			if isInside, _ := changedPath.IsInsideDir(projectPath); isInside {
+				indexes = append(indexes, index)
+				break
+			}
+		}
+	}
+
+	return indexes
+}
+
+// lint runs the rules on the given project and returns its findings.
+func lint(lintedProject project.Type) ([]string, error) {
+	var results result.Type
+	results.Initialize()
+	if err := results.LoadBaseline(); err != nil {
+		return nil, err
+	}
+
+	for _, ruleResult := range rule.Run(lintedProject) {
+		results.Record(lintedProject, ruleResult.Configuration, ruleResult.Result, ruleResult.Output, ruleResult.Locations)
+	}
+
+	return results.Findings(lintedProject), nil
+}
+
+// diffText returns a compact description of the changes between the previous and current findings of the project.
+func diffText(lintedProject project.Type, previousFindings []string, currentFindings []string) string {
+	newFindings := difference(currentFindings, previousFindings)
+	resolvedFindings := difference(previousFindings, currentFindings)
+
+	text := fmt.Sprintf(
+		"Linted %s in %s: %d new, %d resolved, %d total\n",
+		lintedProject.ProjectType,
+		lintedProject.Path,
+		len(newFindings),
+		len(resolvedFindings),
+		len(currentFindings),
+	)
+	for _, finding := range newFindings {
+		text += "+ " + finding + "\n"
+	}
+	for _, finding := range resolvedFindings {
+		text += "- " + finding + "\n"
+	}
+
+	return text
+}
+
+// difference returns the findings of a which are not in b. Duplicate findings are counted individually.
+func difference(a []string, b []string) []string {
+	counts := make(map[string]int)
+	for _, finding := range b {
+		counts[finding]++
+	}
+
+	result := []string{}
+	for _, finding := range a {
+		if counts[finding] > 0 {
+			counts[finding]--
+			continue
+		}
+		result = append(result, finding)
+	}
+
+	return result
+}
@@ -0,0 +1,122 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package watch
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataPath *paths.Path
+
+func init() {
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	testDataPath = paths.New(workingDirectory, "testdata")
+}
+
+// copyTestSketch returns a project for a temporary copy of the test sketch, with the configuration initialized for it.
+func copyTestSketch(t *testing.T) project.Type {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-watch-test")
+	require.Nil(t, err)
+	t.Cleanup(func() { temporaryPath.RemoveAll() })
+
+	sketchPath := temporaryPath.Join("Sketch")
+	require.Nil(t, testDataPath.Join("Sketch").CopyDirTo(sketchPath))
+
+	flags := test.ConfigurationFlags()
+	flags.Set("offline", "true")
+	flags.Set("watch", "true")
+	require.Nil(t, configuration.Initialize(flags, []string{sketchPath.String()}))
+
+	return project.Type{
+		Path:             sketchPath,
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+}
+
+func TestProjects(t *testing.T) {
+	sketch := copyTestSketch(t)
+	result.Results.Initialize()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	watchError := make(chan error)
+	go func() {
+		watchError <- Projects(ctx, []project.Type{sketch})
+	}()
+
+	time.Sleep(debounceDelay)
+	require.Nil(t, sketch.Path.Join("Sketch.ino").WriteFile([]byte("void setup() {}\n")))
+	time.Sleep(3 * debounceDelay)
+	cancel()
+	assert.Nil(t, <-watchError)
+}
+
+func Test_lint(t *testing.T) {
+	sketch := copyTestSketch(t)
+
+	findings, err := lint(sketch)
+	require.Nil(t, err)
+	assert.Empty(t, findings)
+
+	require.Nil(t, sketch.Path.Join("Sketch.ino").Rename(sketch.Path.Join("Foo.ino")))
+	findings, err = lint(sketch)
+	require.Nil(t, err)
+	assert.NotEmpty(t, findings, "Findings reflect the current state of the project files")
+}
+
+func Test_affectedProjects(t *testing.T) {
+	library := project.Type{Path: paths.New("/foo/Library"), ProjectType: projecttype.Library, SuperprojectType: projecttype.Library}
+	example := project.Type{Path: paths.New("/foo/Library/examples/Example"), ProjectType: projecttype.Sketch, SuperprojectType: projecttype.Library}
+	packageIndex := project.Type{Path: paths.New("/foo/package_foo_index.json"), ProjectType: projecttype.PackageIndex, SuperprojectType: projecttype.PackageIndex}
+	projects := []project.Type{library, example, packageIndex}
+
+	assert.Equal(t, []int{0}, affectedProjects(projects, paths.NewPathList("/foo/Library/library.properties")))
+	assert.Equal(t, []int{0, 1}, affectedProjects(projects, paths.NewPathList("/foo/Library/examples/Example/Example.ino")))
+	assert.Equal(t, []int{2}, affectedProjects(projects, paths.NewPathList("/foo/package_foo_index.json")))
+	assert.Empty(t, affectedProjects(projects, paths.NewPathList("/foo/package_bar_index.json")))
+}
+
+func Test_diffText(t *testing.T) {
+	sketch := project.Type{Path: paths.New("/foo/Sketch"), ProjectType: projecttype.Sketch, SuperprojectType: projecttype.Sketch}
+
+	assert.Equal(
+		t,
+		"Linted sketch in /foo/Sketch: 1 new, 1 resolved, 2 total\n+ ERROR SS001: baz\n- ERROR SS002: bar\n",
+		diffText(sketch, []string{"ERROR SS001: foo", "ERROR SS002: bar"}, []string{"ERROR SS001: foo", "ERROR SS001: baz"}),
+	)
+	assert.Equal(t, "Linted sketch in /foo/Sketch: 0 new, 0 resolved, 0 total\n", diffText(sketch, nil, nil))
+}
+
+func Test_difference(t *testing.T) {
+	assert.Equal(t, []string{"bar"}, difference([]string{"foo", "bar"}, []string{"foo"}))
+	assert.Equal(t, []string{"foo"}, difference([]string{"foo", "foo"}, []string{"foo"}), "Duplicates are counted")
+	assert.Empty(t, difference([]string{"foo"}, []string{"foo", "bar"}))
+}
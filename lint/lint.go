@@ -0,0 +1,198 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+/*
+Package lint provides an API for running Arduino Lint from other Go programs.
+
+	report, err := lint.Run(context.Background(), lint.Options{
+		Paths:          []string{"/path/to/MyLibrary"},
+		LibraryManager: "update",
+	})
+	if err != nil {
+		// The configuration is invalid or the projects could not be linted.
+	}
+	if !report.Summary.Pass {
+		// There were rule violations at or above the FailOn level (error by default).
+	}
+
+Arduino Lint logs via the standard logger of the github.com/sirupsen/logrus package.
+*/
+package lint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-lint/internal/cli"
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/fix"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/result"
+	"github.com/arduino/arduino-lint/internal/rule"
+	"github.com/spf13/pflag"
+)
+
+// Options are the settings for a lint run. They are equivalent to the command line flags of the same names.
+// The zero value of each option results in the same behavior as when the flag is not used: the setting from the
+// configuration file is used if present, otherwise the default.
+type Options struct {
+	Paths          []string // Paths of the projects to lint. Default: the current working directory.
+	Compliance     string   // Can be {strict|specification|permissive}.
+	LibraryManager string   // Can be {submit|update|false}.
+	ProjectType    string   // Can be {sketch|library|platform|all}.
+	Recursive      bool
+	Config         string // Path of the configuration file.
+	Baseline       string // Path of the baseline file.
+	ArchivesDir    string // Path of the folder containing the release archives referenced by package indexes.
+	LibraryIndex   string // Path or URL of the Library Manager index.
+	PackageIndex   string // Path of the package index file platforms are verified against.
+	Offline        bool
+	CacheDir       string
+	NoCache        bool
+	Jobs           int
+	Fix            bool     // Correct the mechanically fixable problems in the projects before linting them.
+	Verbose        bool     // Include the results of rules that did not fail in the report.
+	FailOn         string   // Can be {error|warning|info|never}.
+	MaxWarnings    *int     // nil for the setting from the configuration file, if present, otherwise no limit.
+	Rules          []string // IDs, ID prefixes, or globs of the rules to run. Default: all rules.
+	SkipRules      []string // IDs, ID prefixes, or globs of the rules not to run.
+	Categories     []string // Categories or subcategories of the rules to run. Default: all categories.
+}
+
+// runMutex serializes lint runs, since the configuration is global.
+var runMutex sync.Mutex
+
+// Run lints the projects specified by the options and returns the report.
+// Concurrent calls are serialized. If the context is canceled, the projects that are being linted are finished before
+// returning the context's error.
+func Run(ctx context.Context, options Options) (Report, error) {
+	runMutex.Lock()
+	defer runMutex.Unlock()
+
+	flags, err := options.flags()
+	if err != nil {
+		return Report{}, err
+	}
+	defer configuration.RemoveArchiveExtractions()
+	if err := configuration.Initialize(flags, options.Paths); err != nil {
+		return Report{}, fmt.Errorf("Invalid configuration: %v", err)
+	}
+
+	// The Library Manager index and package index may have changed since the previous run.
+	projectdata.ResetSharedData()
+
+	result.Results.Initialize()
+	if err := result.Results.LoadBaseline(); err != nil {
+		return Report{}, fmt.Errorf("Invalid configuration: %v", err)
+	}
+
+	projects, err := project.FindProjects()
+	if err != nil {
+		return Report{}, fmt.Errorf("Error while finding projects: %v", err)
+	}
+
+	if configuration.Fix() {
+		projects, err = fix.Projects(projects)
+		if err != nil {
+			return Report{}, fmt.Errorf("Error while fixing projects: %v", err)
+		}
+	}
+
+	ruleResultsChannels, err := rule.RunProjects(ctx, projects)
+	if err != nil {
+		return Report{}, err
+	}
+	for index, project := range projects {
+		// All results are received, even after cancellation, so that no rules are still running when Run returns.
+		ruleResults := <-ruleResultsChannels[index]
+		if ctx.Err() != nil {
+			continue
+		}
+		rule.Record(project, ruleResults)
+		result.Results.AddProjectSummary(project)
+	}
+	if err := ctx.Err(); err != nil {
+		return Report{}, err
+	}
+
+	result.Results.AddSummary()
+
+	return newReport(result.Results), nil
+}
+
+// flags returns the command line flags equivalent to the options.
+func (options Options) flags() (*pflag.FlagSet, error) {
+	// Use the command line flags so the defaults are the same.
+	flags := cli.Root().PersistentFlags()
+
+	// The report is returned rather than printed, so the text output must be disabled.
+	settings := map[string]string{"format": "json"}
+	stringOptions := map[string]string{
+		"compliance":      options.Compliance,
+		"library-manager": options.LibraryManager,
+		"project-type":    options.ProjectType,
+		"config":          options.Config,
+		"baseline":        options.Baseline,
+		"archives-dir":    options.ArchivesDir,
+		"library-index":   options.LibraryIndex,
+		"package-index":   options.PackageIndex,
+		"cache-dir":       options.CacheDir,
+		"fail-on":         options.FailOn,
+	}
+	for name, value := range stringOptions {
+		if value != "" {
+			settings[name] = value
+		}
+	}
+	boolOptions := map[string]bool{
+		"recursive": options.Recursive,
+		"offline":   options.Offline,
+		"no-cache":  options.NoCache,
+		"verbose":   options.Verbose,
+		"fix":       options.Fix,
+	}
+	for name, value := range boolOptions {
+		if value {
+			settings[name] = "true"
+		}
+	}
+	if options.Jobs != 0 {
+		settings["jobs"] = fmt.Sprint(options.Jobs)
+	}
+	if options.MaxWarnings != nil {
+		settings["max-warnings"] = fmt.Sprint(*options.MaxWarnings)
+	}
+	sliceOptions := map[string][]string{
+		"rules":      options.Rules,
+		"skip-rules": options.SkipRules,
+		"category":   options.Categories,
+	}
+	for name, value := range sliceOptions {
+		if len(value) > 0 {
+			settings[name] = strings.Join(value, ",")
+		}
+	}
+
+	for name, value := range settings {
+		if err := flags.Set(name, value); err != nil {
+			return nil, fmt.Errorf("Invalid %s option value %s: %v", name, value, err)
+		}
+	}
+
+	return flags, nil
+}
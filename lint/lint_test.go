@@ -0,0 +1,120 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataPath *paths.Path
+
+func init() {
+	workingDirectory, err := paths.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	testDataPath = workingDirectory.Join("testdata")
+}
+
+func TestRun(t *testing.T) {
+	sketchPath := testDataPath.Join("Sketch")
+	report, err := Run(context.Background(), Options{Paths: []string{sketchPath.String()}, NoCache: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{sketchPath.String()}, report.Configuration.Paths)
+	require.Len(t, report.Projects, 1)
+	assert.Equal(t, sketchPath.String(), report.Projects[0].Path)
+	assert.Equal(t, "sketch", report.Projects[0].ProjectType)
+	assert.Equal(t, "specification", report.Projects[0].Configuration.Compliance)
+	assert.True(t, report.Summary.Pass)
+	for _, ruleReport := range report.Projects[0].Rules {
+		assert.Equal(t, "fail", ruleReport.Result, "Only failures are reported by default")
+	}
+
+	verboseReport, err := Run(context.Background(), Options{Paths: []string{sketchPath.String()}, NoCache: true, Verbose: true, Compliance: "strict"})
+	require.NoError(t, err)
+	assert.Equal(t, "strict", verboseReport.Projects[0].Configuration.Compliance)
+	assert.Greater(t, len(verboseReport.Projects[0].Rules), len(report.Projects[0].Rules), "Verbose option reports all rules")
+
+	report, err = Run(context.Background(), Options{Paths: []string{sketchPath.String()}, NoCache: true})
+	require.NoError(t, err)
+	assert.Equal(t, "specification", report.Projects[0].Configuration.Compliance, "Options of previous runs don't persist")
+}
+
+func TestRunMultipleProjects(t *testing.T) {
+	report, err := Run(context.Background(), Options{Paths: []string{testDataPath.Join("sketchbook").String()}, Recursive: true, NoCache: true, Jobs: 2})
+	require.NoError(t, err)
+	require.Len(t, report.Projects, 2)
+	assert.Equal(t, testDataPath.Join("sketchbook", "Bar").String(), report.Projects[0].Path)
+	assert.Equal(t, testDataPath.Join("sketchbook", "Foo").String(), report.Projects[1].Path)
+	assert.Equal(t, report.Projects[0].Summary.WarningCount+report.Projects[1].Summary.WarningCount, report.Summary.WarningCount)
+
+	var ruleIDs []string
+	for _, ruleReport := range report.Projects[0].Rules {
+		ruleIDs = append(ruleIDs, ruleReport.ID)
+		if ruleReport.ID == "SC001" {
+			require.NotEmpty(t, ruleReport.Locations)
+			assert.Equal(t, Location{Path: "Bar.ino", Line: 1, Column: 1}, ruleReport.Locations[0])
+		}
+	}
+	assert.Contains(t, ruleIDs, "SC001", "Incorrect Arduino.h case is reported")
+}
+
+func TestRunFailurePolicy(t *testing.T) {
+	options := Options{Paths: []string{testDataPath.Join("sketchbook").String()}, Recursive: true, NoCache: true}
+	report, err := Run(context.Background(), options)
+	require.NoError(t, err)
+	require.Greater(t, report.Summary.WarningCount, 0)
+	require.Zero(t, report.Summary.ErrorCount)
+	assert.True(t, report.Summary.Pass, "Warnings don't cause failure by default")
+
+	options.FailOn = "warning"
+	report, err = Run(context.Background(), options)
+	require.NoError(t, err)
+	assert.False(t, report.Summary.Pass)
+	assert.False(t, report.Projects[0].Summary.Pass)
+
+	options.FailOn = ""
+	maxWarnings := report.Summary.WarningCount - 1
+	options.MaxWarnings = &maxWarnings
+	report, err = Run(context.Background(), options)
+	require.NoError(t, err)
+	assert.False(t, report.Summary.Pass)
+	assert.True(t, report.Projects[0].Summary.Pass, "The warning limit applies to the cumulative count")
+
+	options.FailOn = "foo"
+	_, err = Run(context.Background(), options)
+	assert.Error(t, err)
+}
+
+func TestRunInvalidOptions(t *testing.T) {
+	_, err := Run(context.Background(), Options{Paths: []string{testDataPath.Join("Sketch").String()}, Compliance: "foo"})
+	assert.Error(t, err)
+
+	_, err = Run(context.Background(), Options{Paths: []string{testDataPath.Join("nonexistent").String()}})
+	assert.Error(t, err)
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := Run(ctx, Options{Paths: []string{testDataPath.Join("sketchbook").String()}, Recursive: true, NoCache: true})
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,154 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lint
+
+import (
+	"github.com/arduino/arduino-lint/internal/result"
+)
+
+// Report is the report of a lint run. It has the same structure as the JSON report of the command line tool.
+type Report struct {
+	Configuration ToolConfiguration `json:"configuration"`
+	Projects      []ProjectReport   `json:"projects"`
+	Summary       Summary           `json:"summary"`
+}
+
+// ToolConfiguration is the configuration of the lint run.
+type ToolConfiguration struct {
+	Paths       []string `json:"paths"`
+	ProjectType string   `json:"projectType"`
+	Recursive   bool     `json:"recursive"`
+}
+
+// ProjectReport is the report of the rule results of a project.
+type ProjectReport struct {
+	Path          string               `json:"path"`
+	ProjectType   string               `json:"projectType"`
+	Configuration ProjectConfiguration `json:"configuration"`
+	Changes       []Change             `json:"changes,omitempty"`
+	Rules         []RuleReport         `json:"rules"`
+	Summary       Summary              `json:"summary"`
+}
+
+// ProjectConfiguration is the configuration the rules were run on a project under.
+type ProjectConfiguration struct {
+	Compliance     string `json:"compliance"`
+	LibraryManager string `json:"libraryManager"`
+	Official       bool   `json:"official"`
+}
+
+// Change is a change made to a project by the Fix option.
+type Change struct {
+	Kind        string `json:"kind"`              // Can be {edit|rename}.
+	Path        string `json:"path"`              // Relative to the project path.
+	NewPath     string `json:"newPath,omitempty"` // Relative to the project path.
+	Description string `json:"description"`
+	Applied     bool   `json:"applied"`
+}
+
+// RuleReport is the result of a rule.
+type RuleReport struct {
+	Category    string       `json:"category"`
+	Subcategory string       `json:"subcategory"`
+	ID          string       `json:"ID"`
+	Brief       string       `json:"brief"`
+	Description string       `json:"description"`
+	Result      string       `json:"result"` // Can be {pass|fail|skipped|unable to run}.
+	Level       string       `json:"level"`  // Can be {INFO|WARNING|ERROR|NOTICE}.
+	Message     string       `json:"message"`
+	Locations   []Location   `json:"locations"`
+	Suppression *Suppression `json:"suppression,omitempty"`
+}
+
+// Location is the location of a rule violation.
+type Location struct {
+	Path   string `json:"path"` // Relative to the project path.
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	Key    string `json:"key,omitempty"`
+}
+
+// Suppression describes why a rule violation was suppressed. Suppressed violations don't affect the summary.
+type Suppression struct {
+	Kind          string `json:"kind"` // Can be {baseline|inSource}.
+	Justification string `json:"justification,omitempty"`
+}
+
+// Summary is the summary of rule results.
+type Summary struct {
+	Pass            bool `json:"pass"` // Whether the results pass according to the FailOn and MaxWarnings options.
+	InfoCount       int  `json:"infoCount"`
+	WarningCount    int  `json:"warningCount"`
+	ErrorCount      int  `json:"errorCount"`
+	SuppressedCount int  `json:"suppressedCount"`
+}
+
+// newReport returns the report equivalent to the given results.
+func newReport(results result.Type) Report {
+	report := Report{
+		Configuration: ToolConfiguration{
+			Paths:       []string{},
+			ProjectType: results.Configuration.ProjectType,
+			Recursive:   results.Configuration.Recursive,
+		},
+		Projects: []ProjectReport{},
+		Summary:  Summary(results.Summary),
+	}
+	for _, path := range results.Configuration.Paths {
+		report.Configuration.Paths = append(report.Configuration.Paths, path.String())
+	}
+
+	for _, projectResults := range results.Projects {
+		projectReport := ProjectReport{
+			Path:          projectResults.Path.String(),
+			ProjectType:   projectResults.ProjectType,
+			Configuration: ProjectConfiguration(projectResults.Configuration),
+			Rules:         []RuleReport{},
+			Summary:       Summary(projectResults.Summary),
+		}
+
+		for _, projectChange := range projectResults.Changes {
+			projectReport.Changes = append(projectReport.Changes, Change(projectChange))
+		}
+
+		for _, ruleResults := range projectResults.Rules {
+			ruleReport := RuleReport{
+				Category:    ruleResults.Category,
+				Subcategory: ruleResults.Subcategory,
+				ID:          ruleResults.ID,
+				Brief:       ruleResults.Brief,
+				Description: ruleResults.Description,
+				Result:      ruleResults.Result,
+				Level:       ruleResults.Level,
+				Message:     ruleResults.Message,
+				Locations:   []Location{},
+			}
+			for _, location := range ruleResults.Locations {
+				ruleReport.Locations = append(ruleReport.Locations, Location(location))
+			}
+			if ruleResults.Suppression != nil {
+				suppression := Suppression(*ruleResults.Suppression)
+				ruleReport.Suppression = &suppression
+			}
+
+			projectReport.Rules = append(projectReport.Rules, ruleReport)
+		}
+
+		report.Projects = append(report.Projects, projectReport)
+	}
+
+	return report
+}
@@ -0,0 +1,3 @@
+#include <arduino.h>
+void setup() {}
+void loop() {}
@@ -19,6 +19,7 @@ import (
 	"os"
 
 	"github.com/arduino/arduino-lint/internal/cli"
+	"github.com/arduino/arduino-lint/internal/command"
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/result/feedback"
 )
@@ -29,8 +30,10 @@ func init() {
 
 func main() {
 	rootCommand := cli.Root()
+	rootCommand.SetArgs(cli.Arguments(rootCommand, os.Args[1:]))
 	if err := rootCommand.Execute(); err != nil {
+		// Cobra only returns errors for invalid command line arguments.
 		feedback.Error(err.Error())
-		os.Exit(1)
+		os.Exit(command.ExitConfigurationError)
 	}
 }
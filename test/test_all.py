@@ -15,7 +15,11 @@
 import json
 import pathlib
 import platform
+import shutil
+import tarfile
 import typing
+import xml.etree.ElementTree
+import zipfile
 
 import dateutil.parser
 import invoke.context
@@ -53,6 +57,159 @@ def test_compliance_invalid(run_command):
     assert not result.ok
 
 
+def test_baseline(run_command, working_dir):
+    project_path = test_data_path.joinpath("compliance", "Specification")
+    baseline_file_name = "baseline.json"
+    result = run_command(cmd=["--compliance", "strict", "--write-baseline", baseline_file_name, project_path])
+    assert not result.ok
+    with pathlib.Path(working_dir, baseline_file_name).open() as baseline_file:
+        baseline = json.load(baseline_file)
+    assert len(baseline["findings"]) > 0
+
+    # Only violations not recorded in the baseline cause a failure
+    result = run_command(cmd=["--compliance", "strict", "--baseline", baseline_file_name, project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--baseline", "nonexistent.json", project_path])
+    assert not result.ok
+
+
+def test_suppression_comments(run_command):
+    project_path = test_data_path.joinpath("Suppressed")
+    result = run_command(cmd=["--format", "json", project_path])
+    assert result.ok
+    report = json.loads(result.stdout)
+    suppressions = {rule["ID"]: rule.get("suppression") for rule in report["projects"][0]["rules"]}
+    assert suppressions["LP038"] == {"kind": "inSource", "justification": "The library does not fit any category"}
+    assert suppressions["LC001"]["kind"] == "inSource"
+    assert report["summary"]["errorCount"] == 0
+    assert report["summary"]["suppressedCount"] == 3
+
+
+def test_failure_policy(run_command):
+    project_path = test_data_path.joinpath("Suppressed")
+    result = run_command(cmd=[project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--fail-on", "warning", project_path])
+    assert result.exited == 1
+
+    result = run_command(cmd=["--max-warnings", "0", project_path])
+    assert result.exited == 1
+
+    result = run_command(cmd=["--fail-on", "never", "--compliance", "strict", project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--fail-on", "foo", project_path])
+    assert result.exited == 3
+
+    result = run_command(cmd=["--nonexistent-flag", project_path])
+    assert result.exited == 3
+
+
+def test_archive(run_command, working_dir):
+    project_path = test_data_path.joinpath("compliance", "Specification")
+
+    zip_path = pathlib.Path(working_dir, "Specification.zip")
+    with zipfile.ZipFile(zip_path, "w") as zip_file:
+        for file_path in project_path.iterdir():
+            zip_file.write(file_path, arcname=f"Specification/{file_path.name}")
+    result = run_command(cmd=["--compliance", "specification", zip_path])
+    assert result.ok
+
+    # Project paths are reported relative to the archive, since the extraction folder is temporary
+    result = run_command(cmd=["--format", "json", zip_path])
+    report = json.loads(result.stdout)
+    assert pathlib.PurePath(report["projects"][0]["path"]) == zip_path.joinpath("Specification")
+
+    # Fixes and watching would apply to the temporary extraction folder
+    result = run_command(cmd=["--fix", zip_path])
+    assert result.exited == 3
+    result = run_command(cmd=["--watch", zip_path])
+    assert result.exited == 3
+
+    for mode, extension in [("w:gz", ".tar.gz"), ("w:bz2", ".tar.bz2")]:
+        tar_path = pathlib.Path(working_dir, "Specification" + extension)
+        with tarfile.open(tar_path, mode) as tar_file:
+            tar_file.add(project_path, arcname="Specification")
+        result = run_command(cmd=["--compliance", "specification", tar_path])
+        assert result.ok
+        result = run_command(cmd=["--compliance", "strict", tar_path])
+        assert result.exited == 1
+
+    # Release archives must have a single root folder and no paths outside it
+    for arcname in ["../Specification", "."]:
+        tar_path = pathlib.Path(working_dir, "Invalid.tar.gz")
+        with tarfile.open(tar_path, "w:gz") as tar_file:
+            tar_file.add(project_path, arcname=arcname)
+        result = run_command(cmd=[tar_path])
+        assert result.exited == 3
+
+
+def test_archives_dir(run_command, working_dir):
+    project_path = test_data_path.joinpath("project-type", "PackageIndex")
+
+    result = run_command(cmd=["--archives-dir", working_dir, project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--archives-dir", pathlib.Path(working_dir, "nonexistent"), project_path])
+    assert result.exited == 3
+
+
+def test_package_index(run_command, working_dir):
+    project_path = test_data_path.joinpath("project-type", "Platform")
+    package_index_path = test_data_path.joinpath("project-type", "PackageIndex", "package_valid_index.json")
+
+    result = run_command(cmd=["--package-index", package_index_path, project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--package-index", working_dir, project_path])
+    assert result.exited == 3
+
+    result = run_command(cmd=["--package-index", pathlib.Path(working_dir, "nonexistent.json"), project_path])
+    assert result.exited == 3
+
+
+def test_rule_selection(run_command):
+    project_path = test_data_path.joinpath("Suppressed")
+
+    def rule_ids(cmd):
+        result = run_command(cmd=["--format", "json", "--verbose"] + cmd + [project_path])
+        assert result.ok
+        return {rule["ID"] for rule in json.loads(result.stdout)["projects"][0]["rules"]}
+
+    assert rule_ids(["--rules", "LP01?,LS001"]) <= {"LP01" + str(digit) for digit in range(10)} | {"LS001"}
+    assert "LP012" not in rule_ids(["--skip-rules", "LP012"])
+    assert all(rule_id.startswith("LD") for rule_id in rule_ids(["--category", "documentation"]))
+
+    result = run_command(cmd=["--rules", "LP[0", project_path])
+    assert result.exited == 3
+
+    result = run_command(cmd=["--skip-rules", "LP9", project_path])
+    assert result.exited == 3
+
+    result = run_command(cmd=["--category", "documentaton", project_path])
+    assert result.exited == 3
+
+
+def test_config(run_command):
+    project_path = test_data_path.joinpath("compliance", "Specification")
+    result = run_command(cmd=[project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--config", test_data_path.joinpath("config", "strict.yml"), project_path])
+    assert not result.ok
+
+    # Flags take precedence over the configuration file
+    result = run_command(
+        cmd=["--config", test_data_path.joinpath("config", "strict.yml"), "--compliance", "specification", project_path]
+    )
+    assert result.ok
+
+    result = run_command(cmd=["--config", test_data_path.joinpath("config", "invalid.yml"), project_path])
+    assert not result.ok
+
+
 def test_format(run_command):
     project_path = test_data_path.joinpath("ValidSketch")
     result = run_command(cmd=["--format", "text", project_path])
@@ -64,10 +221,120 @@ def test_format(run_command):
     assert result.ok
     json.loads(result.stdout)
 
+    result = run_command(cmd=["--format", "sarif", project_path])
+    assert result.ok
+    assert json.loads(result.stdout)["version"] == "2.1.0"
+
+    result = run_command(cmd=["--format", "junit", project_path])
+    assert result.ok
+    assert xml.etree.ElementTree.fromstring(result.stdout).tag == "testsuites"
+
+    result = run_command(cmd=["--format", "github-actions", test_data_path.joinpath("InvalidSketch")])
+    assert not result.ok
+    assert result.stdout.startswith("::error ")
+
     result = run_command(cmd=["--format", "foo", project_path])
     assert not result.ok
 
 
+def test_offline(run_command):
+    project_path = test_data_path.joinpath("ValidSketch")
+    result = run_command(cmd=["--offline", project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--library-index", "nonexistent.json", project_path])
+    assert not result.ok
+
+
+def test_fix(run_command, working_dir):
+    project_path = pathlib.Path(working_dir, "Fixable")
+    shutil.copytree(test_data_path.joinpath("Fixable"), project_path)
+    library_properties_path = project_path.joinpath("library.properties")
+    original_library_properties = library_properties_path.read_text()
+
+    result = run_command(cmd=["--dry-run", project_path])
+    assert not result.ok
+
+    result = run_command(cmd=["--fix", "--dry-run", project_path])
+    assert "-version=v1.0.0" in result.stdout
+    assert "+version=1.0.0" in result.stdout
+    assert library_properties_path.read_text() == original_library_properties
+
+    result = run_command(cmd=["--fix", "--dry-run", "--format", "json", project_path])
+    project_report = json.loads(result.stdout)["projects"][0]
+    changes = project_report["changes"]
+    assert {"kind": "rename", "path": "Examples", "newPath": "examples"}.items() <= changes[0].items()
+    assert not changes[0]["applied"]
+    assert "+version=1.0.0" in project_report["diff"]
+
+    # The other formats have no place for the proposed changes
+    result = run_command(cmd=["--fix", "--dry-run", "--format", "sarif", project_path])
+    assert result.exited == 3
+
+    run_command(cmd=["--fix", project_path])
+    assert "version=1.0.0" in library_properties_path.read_text()
+    assert project_path.joinpath("examples", "Foo", "Foo.ino").exists()
+
+
+def test_rules_list(run_command):
+    result = run_command(cmd=["rules", "list", "--format", "json"])
+    assert result.ok
+    rules = json.loads(result.stdout)
+    assert "LP006" in [rule["ID"] for rule in rules]
+
+    result = run_command(
+        cmd=["rules", "list", "--format", "json", "--project-type", "platform", "--category", "structure"]
+    )
+    assert result.ok
+    for rule in json.loads(result.stdout):
+        assert rule["projectType"] == "platform"
+        assert rule["category"] == "structure"
+
+    result = run_command(cmd=["rules", "list", "--format", "json", "--compliance", "permissive"])
+    assert result.ok
+    assert len(json.loads(result.stdout)) < len(rules)
+
+    result = run_command(cmd=["rules", "list", "--format", "markdown"])
+    assert result.ok
+    assert "| LP006 |" in result.stdout
+
+    result = run_command(cmd=["rules", "list", "--compliance", "foo"])
+    assert not result.ok
+
+
+def test_rules_explain(run_command):
+    result = run_command(cmd=["rules", "explain", "PF054"])
+    assert result.ok
+    assert result.stdout.startswith("PF054: ")
+
+    result = run_command(cmd=["rules", "explain", "--format", "json", "lp006"])
+    assert result.ok
+    explanation = json.loads(result.stdout)
+    assert explanation["ID"] == "LP006"
+    level = {"compliance": "strict", "libraryManager": "submit", "official": False, "enabled": True, "level": "ERROR"}
+    assert level in explanation["levels"]
+    assert explanation["enabledBy"] == ["default"]
+    assert explanation["disabledBy"] == []
+
+    result = run_command(cmd=["rules", "explain", "XX999"])
+    assert not result.ok
+
+
+def test_project_path_named_after_command(run_command, working_dir):
+    shutil.copytree(test_data_path.joinpath("ValidSketch"), pathlib.Path(working_dir, "rules"))
+
+    # An existing path named after a command is linted as before the commands were added
+    result = run_command(cmd=["--format", "json", "rules"])
+    report = json.loads(result.stdout)
+    assert pathlib.PurePath(report["projects"][0]["path"]) == pathlib.PurePath("rules")
+    assert report["projects"][0]["projectType"] == "sketch"
+
+    # The command is still run when its arguments are not all existing paths
+    result = run_command(cmd=["rules", "list", "--format", "json"])
+    assert result.ok
+    assert len(json.loads(result.stdout)) > 0
+
+
 def test_help(run_command):
     result = run_command(cmd=["--help"])
     assert result.ok
@@ -125,6 +392,22 @@ def test_recursive(run_command):
     assert result.ok
 
 
+def test_jobs(run_command):
+    projects_path = test_data_path.joinpath("project-type")
+    reports = []
+    for jobs in ["1", "4"]:
+        result = run_command(cmd=["--format", "json", "--recursive", "--jobs", jobs, projects_path])
+        report = json.loads(result.stdout)
+        del report["configuration"]
+        reports.append(report)
+
+    assert len(reports[0]["projects"]) > 1
+    assert reports[0] == reports[1]
+
+    result = run_command(cmd=["--jobs", "-1", projects_path])
+    assert not result.ok
+
+
 def test_report_file(run_command, working_dir):
     project_path = test_data_path.joinpath("ValidSketch")
     compliance = "permissive"
@@ -0,0 +1,2 @@
+// arduino-lint-disable-next-line LC001 -- Only used with a case-insensitive file system
+#include <arduino.h>